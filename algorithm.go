@@ -0,0 +1,172 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Path finding algorithms
+var (
+	AlgorithmShortestPath   = "shortestPath"
+	AlgorithmAllSimplePaths = "allSimplePaths"
+	AlgorithmAllPaths       = "allPaths"
+	AlgorithmDijkstra       = "dijkstra"
+)
+
+// PathOptions restricts the paths searched by the path finding algorithms,
+// zero values are left to the server defaults; all relationships and max
+// depth 1.
+//
+// Example usage;
+//
+//	opts := (&PathOptions{MaxDepth: 3}).Relationship("KNOWS", DirectionOut)
+//	path, err := neo4jConnection.ShortestPath(alice, bob, opts)
+type PathOptions struct {
+	Relationships []TraversalRelationship
+	// MaxDepth is ignored by Dijkstra
+	MaxDepth int
+	// DefaultCost is used by Dijkstra for the relationships which do not
+	// have the cost property
+	DefaultCost float64
+}
+
+// Relationship adds a relationship type to follow in the given direction
+func (opts *PathOptions) Relationship(relationshipType, direction string) *PathOptions {
+	opts.Relationships = append(opts.Relationships, TraversalRelationship{
+		Type:      relationshipType,
+		Direction: direction,
+	})
+	return opts
+}
+
+// ShortestPath finds one of the shortest paths between the nodes, it
+// returns nil if there is not any path
+func (neo4j *Neo4j) ShortestPath(from, to *Node, opts *PathOptions) (*Path, error) {
+	return neo4j.ShortestPathContext(context.Background(), from, to, opts)
+}
+
+// ShortestPathContext is like ShortestPath but aborts the request when ctx
+// is done
+func (neo4j *Neo4j) ShortestPathContext(ctx context.Context, from, to *Node, opts *PathOptions) (*Path, error) {
+	paths, err := neo4j.findPaths(ctx, from, to, AlgorithmShortestPath, "", opts)
+	return firstPath(paths, err)
+}
+
+// ShortestPaths finds all the shortest paths between the nodes
+func (neo4j *Neo4j) ShortestPaths(from, to *Node, opts *PathOptions) ([]Path, error) {
+	return neo4j.ShortestPathsContext(context.Background(), from, to, opts)
+}
+
+// ShortestPathsContext is like ShortestPaths but aborts the request when ctx
+// is done
+func (neo4j *Neo4j) ShortestPathsContext(ctx context.Context, from, to *Node, opts *PathOptions) ([]Path, error) {
+	return neo4j.findPaths(ctx, from, to, AlgorithmShortestPath, "", opts)
+}
+
+// AllSimplePaths finds all the paths between the nodes which do not visit
+// a node more than once
+func (neo4j *Neo4j) AllSimplePaths(from, to *Node, opts *PathOptions) ([]Path, error) {
+	return neo4j.AllSimplePathsContext(context.Background(), from, to, opts)
+}
+
+// AllSimplePathsContext is like AllSimplePaths but aborts the request when
+// ctx is done
+func (neo4j *Neo4j) AllSimplePathsContext(ctx context.Context, from, to *Node, opts *PathOptions) ([]Path, error) {
+	return neo4j.findPaths(ctx, from, to, AlgorithmAllSimplePaths, "", opts)
+}
+
+// AllPaths finds all the paths between the nodes which do not visit a
+// relationship more than once
+func (neo4j *Neo4j) AllPaths(from, to *Node, opts *PathOptions) ([]Path, error) {
+	return neo4j.AllPathsContext(context.Background(), from, to, opts)
+}
+
+// AllPathsContext is like AllPaths but aborts the request when ctx is done
+func (neo4j *Neo4j) AllPathsContext(ctx context.Context, from, to *Node, opts *PathOptions) ([]Path, error) {
+	return neo4j.findPaths(ctx, from, to, AlgorithmAllPaths, "", opts)
+}
+
+// Dijkstra finds one of the cheapest paths between the nodes, the cost of a
+// relationship is its numeric costProperty. Weight of the returned path is
+// its total cost, nil is returned if there is not any path
+func (neo4j *Neo4j) Dijkstra(from, to *Node, costProperty string, opts *PathOptions) (*Path, error) {
+	return neo4j.DijkstraContext(context.Background(), from, to, costProperty, opts)
+}
+
+// DijkstraContext is like Dijkstra but aborts the request when ctx is done
+func (neo4j *Neo4j) DijkstraContext(ctx context.Context, from, to *Node, costProperty string, opts *PathOptions) (*Path, error) {
+	if costProperty == "" {
+		return nil, errors.New("Cost property is not given")
+	}
+
+	paths, err := neo4j.findPaths(ctx, from, to, AlgorithmDijkstra, costProperty, opts)
+	return firstPath(paths, err)
+}
+
+// findPaths runs the algorithm on the paths endpoint of the start node, the
+// endpoint returns an empty list instead of 404 when there is not any path
+func (neo4j *Neo4j) findPaths(ctx context.Context, from, to *Node, algorithm, costProperty string, opts *PathOptions) ([]Path, error) {
+	if from == nil || from.ID == "" || to == nil || to.ID == "" {
+		return nil, errors.New("Node Id not valid")
+	}
+
+	body := map[string]interface{}{
+		"to":        fmt.Sprintf("%s/%s", neo4j.NodeURL, to.ID),
+		"algorithm": algorithm,
+	}
+
+	if costProperty != "" {
+		body["cost_property"] = costProperty
+	}
+
+	if opts != nil {
+		if len(opts.Relationships) > 0 {
+			for _, relationship := range opts.Relationships {
+				if err := validateDirection(relationship.Direction); err != nil {
+					return nil, err
+				}
+			}
+			body["relationships"] = opts.Relationships
+		}
+
+		if opts.MaxDepth > 0 {
+			body["max_depth"] = opts.MaxDepth
+		}
+
+		if opts.DefaultCost != 0 {
+			body["default_cost"] = opts.DefaultCost
+		}
+	}
+
+	encodedBody, err := jsonEncode(body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := neo4j.doRequest(ctx, "POST", fmt.Sprintf("%s/%s/paths", neo4j.NodeURL, from.ID), encodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0)
+	if err := json.Unmarshal([]byte(response), &items); err != nil {
+		return nil, err
+	}
+
+	result, err := decodeTraversal(neo4j, items, TraversePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]Path), nil
+}
+
+func firstPath(paths []Path, err error) (*Path, error) {
+	if err != nil || len(paths) == 0 {
+		return nil, err
+	}
+
+	return &paths[0], nil
+}
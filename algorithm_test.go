@@ -0,0 +1,152 @@
+package neo4j
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPathFinding(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	first := batch.CreateRef(createNewNode())
+	second := batch.CreateRef(createNewNode())
+	third := batch.CreateRef(createNewNode())
+	batch.Create(&Relationship{Type: "PATH_TEST", StartNodeID: first.ID(), EndNodeID: second.ID(), Data: map[string]interface{}{"cost": 1}})
+	batch.Create(&Relationship{Type: "PATH_TEST", StartNodeID: second.ID(), EndNodeID: third.ID(), Data: map[string]interface{}{"cost": 1}})
+	batch.Create(&Relationship{Type: "PATH_TEST", StartNodeID: first.ID(), EndNodeID: third.ID(), Data: map[string]interface{}{"cost": 5}})
+
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := (&PathOptions{MaxDepth: 3}).Relationship("PATH_TEST", DirectionOut)
+
+	path, err := neo4jConnection.ShortestPath(first.Node(), third.Node(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path == nil || path.Length != 1 {
+		t.Error("Shortest path is not valid", path)
+	}
+
+	paths, err := neo4jConnection.AllSimplePaths(first.Node(), third.Node(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(paths) != 2 {
+		t.Error("Simple paths are not valid", len(paths))
+	}
+
+	path, err = neo4jConnection.Dijkstra(first.Node(), third.Node(), "cost", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path == nil || path.Length != 2 || path.Weight != 2 {
+		t.Error("Cheapest path is not valid", path)
+	}
+
+	path, err = neo4jConnection.ShortestPath(third.Node(), first.Node(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != nil {
+		t.Error("Path should not be found in reverse direction", path)
+	}
+}
+
+func TestPathFindingRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/db/data/node/1/paths" {
+			t.Error("Paths url is not valid", r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		request := make(map[string]interface{})
+		json.Unmarshal(body, &request)
+
+		base := "http://" + r.Host + "/db/data"
+		if request["to"] != base+"/node/2" {
+			t.Error("Target node is not valid", request["to"])
+		}
+
+		if request["algorithm"] != AlgorithmDijkstra || request["cost_property"] != "cost" {
+			t.Error("Algorithm is not valid", request["algorithm"], request["cost_property"])
+		}
+
+		if request["max_depth"] != float64(4) || request["default_cost"] != 1.5 {
+			t.Error("Options are not valid", request["max_depth"], request["default_cost"])
+		}
+
+		relationships, _ := request["relationships"].([]interface{})
+		if len(relationships) != 1 {
+			t.Fatal("Relationships are not valid", request["relationships"])
+		}
+
+		relationship := relationships[0].(map[string]interface{})
+		if relationship["type"] != "ROAD" || relationship["direction"] != DirectionOut {
+			t.Error("Relationship is not valid", relationship)
+		}
+
+		fmt.Fprintf(w, `[{
+			"start" : "%[1]s/node/1",
+			"end" : "%[1]s/node/2",
+			"nodes" : ["%[1]s/node/1", "%[1]s/node/3", "%[1]s/node/2"],
+			"relationships" : ["%[1]s/relationship/7", "%[1]s/relationship/8"],
+			"length" : 2,
+			"weight" : 3.5
+		}]`, base)
+	}))
+	defer server.Close()
+
+	opts := (&PathOptions{MaxDepth: 4, DefaultCost: 1.5}).Relationship("ROAD", DirectionOut)
+	path, err := Connect(server.URL).Dijkstra(&Node{ID: "1"}, &Node{ID: "2"}, "cost", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path.Weight != 3.5 || path.Length != 2 || path.End.ID != "2" {
+		t.Error("Path is not valid", path)
+	}
+
+	if len(path.Nodes) != 3 || path.Nodes[1].ID != "3" || path.Relationships[1].ID != "8" {
+		t.Error("Path entities are not valid", path.Nodes, path.Relationships)
+	}
+}
+
+func TestPathFindingNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	path, err := Connect(server.URL).ShortestPath(&Node{ID: "1"}, &Node{ID: "2"}, nil)
+	if err != nil || path != nil {
+		t.Error("Missing path should not be an error", path, err)
+	}
+}
+
+func TestPathFindingValidation(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	if _, err := neo4jConnection.AllSimplePaths(&Node{ID: "1"}, nil, nil); err == nil {
+		t.Error("Target node should be validated")
+	}
+
+	if _, err := neo4jConnection.Dijkstra(&Node{ID: "1"}, &Node{ID: "2"}, "", nil); err == nil {
+		t.Error("Cost property should be validated")
+	}
+
+	opts := (&PathOptions{}).Relationship("ROAD", "sideways")
+	if _, err := neo4jConnection.ShortestPaths(&Node{ID: "1"}, &Node{ID: "2"}, opts); err == nil {
+		t.Error("Direction should be validated")
+	}
+}
@@ -0,0 +1,111 @@
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ErrPasswordChangeRequired is matched by the errors returned when the
+// password of the user must be changed before using the database, see
+// ChangePassword
+//
+//	if errors.Is(err, ErrPasswordChangeRequired) {
+//		err = neo4jConnection.ChangePassword("neo4j", newPassword)
+//	}
+var ErrPasswordChangeRequired = errors.New("Password change required")
+
+// Authenticator adds the credentials to the requests, it is applied on
+// every request when set as Neo4j.Auth
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// BasicAuth authenticates the requests with http basic authentication
+type BasicAuth struct {
+	User     string
+	Password string
+
+	// mu guards Password against ChangePassword
+	mu sync.RWMutex
+}
+
+// Authenticate implements Authenticator interface
+func (a *BasicAuth) Authenticate(req *http.Request) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	req.SetBasicAuth(a.User, a.Password)
+	return nil
+}
+
+// BearerAuth authenticates the requests with a bearer token
+type BearerAuth struct {
+	Token string
+}
+
+// Authenticate implements Authenticator interface
+func (a *BearerAuth) Authenticate(req *http.Request) error {
+	if a.Token == "" {
+		return errors.New("Token is not given")
+	}
+
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// HeaderAuth authenticates the requests with the given headers, eg: an api
+// key required by a proxy
+type HeaderAuth map[string]string
+
+// Authenticate implements Authenticator interface
+func (a HeaderAuth) Authenticate(req *http.Request) error {
+	for key, value := range a {
+		req.Header.Set(key, value)
+	}
+
+	return nil
+}
+
+// ChangePassword changes the password of the user, the credentials of the
+// connection are updated if they belong to the user. It is safe to call
+// while the connection is used by other goroutines
+func (neo4j *Neo4j) ChangePassword(user, password string) error {
+	return neo4j.ChangePasswordContext(context.Background(), user, password)
+}
+
+// ChangePasswordContext is like ChangePassword but aborts the request when
+// ctx is done
+func (neo4j *Neo4j) ChangePasswordContext(ctx context.Context, user, password string) error {
+	if user == "" || password == "" {
+		return errors.New("User and password must be set")
+	}
+
+	body, err := jsonEncode(map[string]string{"password": password})
+	if err != nil {
+		return err
+	}
+
+	// user endpoints are next to the data api, eg: /user/neo4j/password
+	passwordURL := strings.TrimSuffix(neo4j.BaseURL, "/db/data") + "/user/" + url.PathEscape(user) + "/password"
+	if _, err := neo4j.doRequest(ctx, "POST", passwordURL, body); err != nil {
+		return err
+	}
+
+	if auth, ok := neo4j.Auth.(*BasicAuth); ok && auth.User == user {
+		auth.mu.Lock()
+		auth.Password = password
+		auth.mu.Unlock()
+	}
+
+	neo4j.authMu.Lock()
+	if neo4j.BasicAuthUser == user {
+		neo4j.BasicAuthPassword = password
+	}
+	neo4j.authMu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestAuthenticators(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		auth     Authenticator
+		key      string
+		expected string
+	}{
+		{&BearerAuth{Token: "secret"}, "Authorization", "Bearer secret"},
+		{HeaderAuth{"X-Api-Key": "key"}, "X-Api-Key", "key"},
+		// empty passwords are allowed
+		{&BasicAuth{User: "neo4j"}, "Authorization", "Basic bmVvNGo6"},
+	}
+
+	for _, test := range tests {
+		neo4jConnection, err := ConnectWithOptions(server.URL, WithAuthenticator(test.auth))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := neo4jConnection.GetAllLabels(); err != nil {
+			t.Fatal(err)
+		}
+
+		if header.Get(test.key) != test.expected {
+			t.Error("Request is not authenticated", test.key, header.Get(test.key))
+		}
+	}
+
+	neo4jConnection := Connect(server.URL)
+	neo4jConnection.Auth = &BearerAuth{}
+	if _, err := neo4jConnection.GetAllLabels(); err == nil {
+		t.Error("Authentication error should be returned")
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	password := "neo4j"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/user/neo4j/password" {
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != `{"password":"changed"}` {
+				t.Error("Request body is not valid", string(body))
+			}
+
+			password = "changed"
+			fmt.Fprint(w, `{}`)
+			return
+		}
+
+		if _, p, _ := r.BasicAuth(); p != "changed" || password != "changed" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `{
+				"password_change" : "http://%s/user/neo4j/password",
+				"errors" : [{"code" : "Neo.ClientError.Security.AuthorizationFailed", "message" : "User is required to change their password."}]
+			}`, r.Host)
+			return
+		}
+
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	neo4jConnection, err := ConnectWithOptions(server.URL, WithBasicAuth("neo4j", "neo4j"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = neo4jConnection.GetAllLabels()
+	if !errors.Is(err, ErrPasswordChangeRequired) {
+		t.Fatal("Password change is not required", err)
+	}
+
+	if err := neo4jConnection.ChangePassword("neo4j", "changed"); err != nil {
+		t.Fatal(err)
+	}
+
+	if neo4jConnection.BasicAuthPassword != "changed" {
+		t.Error("Password of the connection is not updated")
+	}
+
+	if _, err := neo4jConnection.GetAllLabels(); err != nil {
+		t.Error(err)
+	}
+}
+
+// run with -race, requests read the credentials while they are changed
+func TestChangePasswordConcurrently(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		fmt.Fprint(w, `["Person"]`)
+	}))
+	defer server.Close()
+
+	connections := []*Neo4j{Connect(server.URL), Connect(server.URL)}
+	connections[0].BasicAuthUser, connections[0].BasicAuthPassword = "neo4j", "old"
+	connections[1].Auth = &BasicAuth{User: "neo4j", Password: "old"}
+
+	for _, neo4jConnection := range connections {
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 10; j++ {
+					if _, err := neo4jConnection.GetAllLabels(); err != nil {
+						t.Error(err)
+					}
+				}
+			}()
+		}
+
+		for i := 0; i < 10; i++ {
+			if err := neo4jConnection.ChangePassword("neo4j", fmt.Sprint("new", i)); err != nil {
+				t.Error(err)
+			}
+		}
+
+		wg.Wait()
+	}
+}
@@ -1,7 +1,7 @@
 package neo4j
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"reflect"
 	"strconv"
@@ -13,6 +13,12 @@ type Batcher interface {
 	mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error)
 }
 
+// batchResponseReceiver is implemented by Batchers which need the whole
+// response of their operation, it is called before mapBatchResponse
+type batchResponseReceiver interface {
+	receiveBatchResponse(response *BatchResponse)
+}
+
 // Basic operation names
 var (
 	BatchGet          = "get"
@@ -26,6 +32,12 @@ var (
 type Batch struct {
 	Neo4j *Neo4j
 	Stack []*BatchRequest
+
+	refs []*Reference
+
+	// cluster routes the requests to its members if the batch is created
+	// by Cluster.NewBatch
+	cluster *Cluster
 }
 
 // BatchRequest All batch request structs will be encapslated in this struct
@@ -40,6 +52,11 @@ type BatchResponse struct {
 	Location string      `json:"location"`
 	Body     interface{} `json:"body"`
 	From     string      `json:"from"`
+	Status   int         `json:"status"`
+
+	// Error is the error of the operation, it is a *Neo4jError if the
+	// server reports one or the error of mapping the result otherwise
+	Error error `json:"-"`
 }
 
 // ManuelBatchRequest is here to support referance passing requests in a transaction
@@ -93,6 +110,22 @@ func (neo4j *Neo4j) GetManualBatchResponse(mbr *ManuelBatchRequest, result inter
 			tempResult[i].mapBatchResponse(neo4j, value)
 		}
 		(*result.(*[]Relationship)) = tempResult
+	case "*neo4j.Path":
+		path, err := decodePath(neo4j, mbr.Response)
+		if err != nil {
+			return err
+		}
+		(*result.(*Path)) = *path
+	case "*[]neo4j.Path":
+		if typeOfResponse != "[]interface {}" {
+			return errors.New("Response is not an array")
+		}
+
+		paths, err := decodeTraversal(neo4j, mbr.Response.([]interface{}), TraversePath)
+		if err != nil {
+			return err
+		}
+		(*result.(*[]Path)) = paths.([]Path)
 	}
 
 	return nil
@@ -181,6 +214,7 @@ func (batch *Batch) Update(obj Batcher) *Batch {
 }
 
 // CreateUnique Batch unique create request to Neo4j
+// properties.Created is set after the batch is executed
 func (batch *Batch) CreateUnique(obj Batcher, properties *Unique) *Batch {
 
 	//encapsulating the object
@@ -207,56 +241,268 @@ func (batch *Batch) addToStack(operation string, obj Batcher) {
 
 // Execute Prepares and sends the request to Neo4j
 // If the request is successful then parses the response
+//
+// The response is streamed, so a failing operation is detected as soon as it
+// arrives. If an operation fails Neo4j rolls back the whole batch and none
+// of the results are mapped into the Batchers.
+//
+// If the results of some operations can not be mapped, the others are still
+// mapped and a *BatchError is returned for the first failing one. The error
+// of every operation is in the Error field of its response.
 func (batch *Batch) Execute() ([]*BatchResponse, error) {
+	return batch.ExecuteContext(context.Background())
+}
+
+// ExecuteContext is like Execute but aborts the request when ctx is done
+func (batch *Batch) ExecuteContext(ctx context.Context) ([]*BatchResponse, error) {
 
 	// if Neo4j instance is not created return an error
 	if batch.Neo4j == nil {
 		return nil, errors.New("Batch request is not created by NewBatch method!")
 	}
+
+	// send the stack in chunks if it is bigger than the configured limit
+	size := len(batch.Stack)
+	if max := batch.Neo4j.MaxBatchSize; max > 0 && max < size {
+		size = max
+	}
+
+	return batch.execute(ctx, size, false, nil)
+}
+
+// ExecuteFunc is like Execute but does not keep the responses, every
+// response is mapped and passed to fn as soon as it arrives, so the memory
+// use does not grow with the batch size.
+//
+// The results of the operations are passed to fn before the batch succeeds,
+// if an operation fails Neo4j rolls back the batch, including the operations
+// already passed to fn. Mapping errors are not returned, they are in the
+// Error field of the responses. If fn returns an error the rest of the
+// response is not read and the error is returned. Streamed batches are not
+// retried and they are sent to the master of a cluster, because their
+// responses can not be taken back.
+func (batch *Batch) ExecuteFunc(fn func(*BatchResponse) error) error {
+	return batch.ExecuteFuncContext(context.Background(), fn)
+}
+
+// ExecuteFuncContext is like ExecuteFunc but aborts the request when ctx is
+// done
+func (batch *Batch) ExecuteFuncContext(ctx context.Context, fn func(*BatchResponse) error) error {
+	if batch.Neo4j == nil {
+		return errors.New("Batch request is not created by NewBatch method!")
+	}
+
+	size := len(batch.Stack)
+	if max := batch.Neo4j.MaxBatchSize; max > 0 && max < size {
+		size = max
+	}
+
+	_, err := batch.execute(ctx, size, false, fn)
+	return err
+}
+
+// execute sends the stack in chunks of given size, a chunk is sent after the
+// previous one succeeds. Errors are wrapped in a *ChunkError if the stack is
+// split or chunked is set. If handle is given the responses are passed to it
+// instead of being returned
+func (batch *Batch) execute(ctx context.Context, size int, chunked bool, handle func(*BatchResponse) error) ([]*BatchResponse, error) {
 	// cache batch stack length
 	stackLength := len(batch.Stack)
 
 	//create result array
-	response := make([]*BatchResponse, stackLength)
+	response := make([]*BatchResponse, 0, stackLength)
 
 	if stackLength == 0 {
 		return response, nil
 	}
 
+	chunks := (stackLength + size - 1) / size
+
+	// locations of the entities returned by the executed chunks, they are
+	// used to resolve the references of the next chunks
+	locations := make(map[int]string)
+
+	for chunk := 0; chunk < chunks; chunk++ {
+		start := chunk * size
+		end := start + size
+		if end > stackLength {
+			end = stackLength
+		}
+
+		chunkResponse, err := batch.executeChunk(ctx, start, end, locations, handle)
+		if err != nil {
+			if chunks == 1 && !chunked {
+				return nil, err
+			}
+
+			return response, &ChunkError{Chunk: chunk, Start: start, End: end, Err: err}
+		}
+
+		response = append(response, chunkResponse...)
+	}
+
+	// all operations are executed, report the first one which could not be
+	// mapped, the others are left in the responses
+	var err error
+	for _, val := range response {
+		if val.Error != nil {
+			err = batch.newError(val)
+			break
+		}
+	}
+
+	// do a clean
+	batch.Stack = make([]*BatchRequest, 0)
+	batch.refs = nil
+
+	return response, err
+}
+
+// executeChunk sends the operations from start to end, the ids of the
+// responses are the indexes of the operations in the whole stack
+func (batch *Batch) executeChunk(ctx context.Context, start, end int, locations map[int]string, handle func(*BatchResponse) error) ([]*BatchResponse, error) {
 	// prepare request
-	request, err := prepareRequest(batch.Stack)
+	request, err := batch.prepareRequest(start, end)
 	if err != nil {
 		return nil, err
 	}
 
+	if start > 0 {
+		if err := batch.rewriteReferences(request, start, end, locations); err != nil {
+			return nil, err
+		}
+	}
+
 	encodedRequest, err := jsonEncode(request)
-	res, err := batch.Neo4j.doBatchRequest("POST", batch.Neo4j.BatchURL, encodedRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal([]byte(res), &response)
+	if handle != nil {
+		if batch.cluster == nil {
+			return nil, batch.stream(ctx, batch.Neo4j, encodedRequest, start, locations, handle)
+		}
+
+		return nil, batch.cluster.do(ctx, true, func(member *Neo4j) error {
+			return batch.stream(ctx, member, encodedRequest, start, locations, handle)
+		})
+	}
+
+	readOnly := isReadOnly(request)
+	if batch.cluster == nil {
+		return batch.send(ctx, batch.Neo4j, readOnly, encodedRequest, start, end, locations)
+	}
+
+	var response []*BatchResponse
+	err = batch.cluster.do(ctx, !readOnly, func(member *Neo4j) error {
+		var err error
+		response, err = batch.send(ctx, member, readOnly, encodedRequest, start, end, locations)
+		return err
+	})
+
+	return response, err
+}
+
+// send sends the prepared chunk to the given server and maps the responses
+func (batch *Batch) send(ctx context.Context, neo4j *Neo4j, readOnly bool, encodedRequest string, start, end int, locations map[int]string) ([]*BatchResponse, error) {
+	response := make([]*BatchResponse, 0, end-start)
+
+	// read only batches can be retried after any failure, the others only
+	// if Neo4j reports a transient error, because then the batch is rolled
+	// back
+	err := neo4j.retry(ctx, readOnly, func() error {
+		response = response[:0]
+
+		return neo4j.doBatchRequest(ctx, "POST", neo4j.BatchURL, encodedRequest, func(val *BatchResponse) error {
+			val.ID += start
+			response = append(response, val)
+
+			// in streaming mode Neo4j always responds with 200, the failing
+			// operation is the last one and it has an error status
+			if val.Status >= 400 {
+				val.Error = newBatchError(val)
+				return batch.newError(val)
+			}
+
+			return nil
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// do mapping here for later usage
-	batch.mapResponse(response)
+	// Neo4j rolls back the whole batch if an operation fails, so the results
+	// are mapped only after all of them succeed
+	for _, val := range response {
+		if location := locationFromResponse(val); location != "" {
+			locations[val.ID] = location
+		}
 
-	// do a clean
-	batch.Stack = make([]*BatchRequest, 0)
+		// mapping errors do not stop the batch, they are reported after all
+		// operations are mapped
+		val.Error = batch.mapResponse(neo4j, val)
+	}
+
+	batch.resolveReferences(response)
 
 	return response, nil
 }
 
-// prepares batch request as slice of map
-func prepareRequest(stack []*BatchRequest) ([]map[string]interface{}, error) {
-	request := make([]map[string]interface{}, len(stack))
-	for i, value := range stack {
+// stream sends the prepared chunk to the given server, every response is
+// mapped and passed to handle as it arrives and it is not kept
+func (batch *Batch) stream(ctx context.Context, neo4j *Neo4j, encodedRequest string, start int, locations map[int]string, handle func(*BatchResponse) error) error {
+	ids := make(map[int]string)
+
+	err := neo4j.doBatchRequest(ctx, "POST", neo4j.BatchURL, encodedRequest, func(val *BatchResponse) error {
+		val.ID += start
+		if val.Status >= 400 {
+			val.Error = newBatchError(val)
+			return batch.newError(val)
+		}
+
+		if location := locationFromResponse(val); location != "" {
+			locations[val.ID] = location
+			ids[val.ID] = idFromResponse(val)
+		}
+
+		val.Error = batch.mapResponse(neo4j, val)
+
+		return handle(val)
+	})
+	if err != nil {
+		return err
+	}
+
+	batch.resolveIDs(ids)
+
+	return nil
+}
+
+// isReadOnly checks if all operations of the prepared request are GETs
+func isReadOnly(request []map[string]interface{}) bool {
+	for _, query := range request {
+		if query["method"] != "GET" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// prepares batch request of the operations from start to end as slice of
+// map, ids of the operations start from zero
+func (batch *Batch) prepareRequest(start, end int) ([]map[string]interface{}, error) {
+	request := make([]map[string]interface{}, end-start)
+	for i, value := range batch.Stack[start:end] {
 		// interface has this method getBatchQuery()
 		query, err := value.Data.getBatchQuery(value.Operation)
 		if err != nil {
-			return nil, err
+			return nil, &BatchError{
+				Index:     start + i,
+				Operation: value.Operation,
+				Data:      value.Data,
+				Err:       err,
+			}
 		}
 		query["id"] = i
 		request[i] = query
@@ -266,12 +512,26 @@ func prepareRequest(stack []*BatchRequest) ([]map[string]interface{}, error) {
 }
 
 // map incoming response, it will update request's nodes and relationships
-func (batch *Batch) mapResponse(response []*BatchResponse) {
+func (batch *Batch) mapResponse(neo4j *Neo4j, val *BatchResponse) error {
+	// id is an Neo4j batch request feature, it returns back the id that we send
+	// so we can use it here to map results into our stack
+	id := val.ID
+	if receiver, ok := batch.Stack[id].Data.(batchResponseReceiver); ok {
+		receiver.receiveBatchResponse(val)
+	}
 
-	for _, val := range response {
-		// id is an Neo4j batch request feature, it returns back the id that we send
-		// so we can use it here to map results into our stack
-		id := val.ID
-		batch.Stack[id].Data.mapBatchResponse(batch.Neo4j, val.Body)
+	_, err := batch.Stack[id].Data.mapBatchResponse(neo4j, val.Body)
+	return err
+}
+
+// newError creates a BatchError for the operation of the response
+func (batch *Batch) newError(val *BatchResponse) error {
+	request := batch.Stack[val.ID]
+
+	return &BatchError{
+		Index:     val.ID,
+		Operation: request.Operation,
+		Data:      request.Data,
+		Err:       val.Error,
 	}
 }
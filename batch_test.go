@@ -1,8 +1,12 @@
 package neo4j
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -445,3 +449,274 @@ func TestBatchWithUnique(t *testing.T) {
 		t.Error(len(res), "Response length is not valid")
 	}
 }
+
+func TestCreateUniqueNodeReportsCreated(t *testing.T) {
+	neo4jConnection := Connect("")
+	value := uniqueLabel("uniqueNode")
+
+	unique := &Unique{IndexName: "uniqueNodes", Key: "id", Value: value}
+	node := createNewNode()
+	created, err := neo4jConnection.CreateUnique(node, unique)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !created || node.ID == "" {
+		t.Error("Node is not created", node)
+	}
+
+	unique = &Unique{IndexName: "uniqueNodes", Key: "id", Value: value}
+	existing := createNewNode()
+	created, err = neo4jConnection.CreateUnique(existing, unique)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created || existing.ID != node.ID {
+		t.Error("Existing node is not returned", existing)
+	}
+
+	unique = &Unique{
+		IndexName:  "uniqueNodes",
+		Key:        "id",
+		Value:      value,
+		Uniqueness: UniquenessCreateOrFail,
+	}
+	if _, err := neo4jConnection.CreateUnique(createNewNode(), unique); err == nil {
+		t.Error("create_or_fail must fail for existing node")
+	}
+}
+
+func TestUniqueRequestCreatedStatus(t *testing.T) {
+	responses := []struct {
+		response *BatchResponse
+		created  bool
+	}{
+		{&BatchResponse{Status: http.StatusCreated, Location: "http://localhost:7474/db/data/node/1"}, true},
+		{&BatchResponse{Status: http.StatusOK, Location: "http://localhost:7474/db/data/node/1"}, false},
+		{&BatchResponse{Status: http.StatusOK}, false},
+		{&BatchResponse{Location: "http://localhost:7474/db/data/node/1"}, true},
+	}
+
+	for _, r := range responses {
+		ur := &UniqueRequest{Properties: &Unique{}, Data: &Node{}}
+		ur.receiveBatchResponse(r.response)
+
+		if ur.Properties.Created != r.created {
+			t.Error("Created is not valid", r.response.Status, r.response.Location)
+		}
+	}
+}
+
+func TestCreateUniqueRelationshipWithNumericValue(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	node := createNewNode()
+	node2 := createNewNode()
+	if _, err := neo4jConnection.NewBatch().Create(node).Create(node2).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	id, _ := strconv.Atoi(node.ID)
+
+	for i, expected := range []bool{true, false} {
+		relationship := crateRelationship(neo4jConnection, node, node2)
+		unique := &Unique{IndexName: "uniqueRelationships", Key: "startNode", Value: id}
+
+		created, err := neo4jConnection.CreateUnique(relationship, unique)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if created != expected {
+			t.Error(i, "created is not valid", created)
+		}
+
+		if relationship.ID == "" {
+			t.Error("Relationship id is not set")
+		}
+	}
+}
+
+func TestUniqueRequestQuery(t *testing.T) {
+	request := &UniqueRequest{
+		Properties: &Unique{IndexName: "my index", Key: "id", Value: 3},
+		Data:       createNewNode(),
+	}
+
+	query, err := request.getBatchQuery(BatchCreateUnique)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query["to"] != "/index/node/my%20index?uniqueness=get_or_create" {
+		t.Error("Unique request url is not valid", query["to"])
+	}
+
+	if query["body"].(map[string]interface{})["value"] != 3 {
+		t.Error("Unique value is not valid", query["body"])
+	}
+
+	request.Properties.Uniqueness = "invalid"
+	if _, err := request.getBatchQuery(BatchCreateUnique); err == nil {
+		t.Error("Invalid uniqueness is accepted")
+	}
+}
+
+func TestBatchResponseIsStreamed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Stream") != "true" {
+			t.Error("Batch request is not streamed")
+		}
+
+		self := "http://" + r.Host + "/db/data/node/3"
+		fmt.Fprintf(w, `[{"id":0,"from":"/node","location":"%s","body":{"self":"%s","data":{}},"status":201},`, self, self)
+		fmt.Fprint(w, `{"id":1,"from":"/node/9","body":{"message":"Cannot find node with id [9] in database.","exception":"NodeNotFoundException"},"status":404}]`)
+	}))
+	defer server.Close()
+
+	neo4jConnection := Connect(server.URL)
+
+	created := &Node{}
+	batch := neo4jConnection.NewBatch()
+	batch.Create(created)
+	batch.Get(&Node{ID: "9"})
+
+	_, err := batch.Execute()
+
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		t.Fatal("Error is not a Neo4jError", err)
+	}
+
+	if nerr.Index != 1 || nerr.StatusCode != 404 || nerr.Exception != "NodeNotFoundException" {
+		t.Error("Error details are not valid", nerr)
+	}
+
+	// the batch is rolled back, the created node does not exist
+	if created.ID != "" {
+		t.Error("Operations of a failed batch should not be mapped", created.ID)
+	}
+
+	var berr *BatchError
+	if !errors.As(err, &berr) {
+		t.Fatal("Error is not a BatchError", err)
+	}
+
+	if berr.Index != 1 || berr.Operation != BatchGet || berr.Data != batch.Stack[1].Data {
+		t.Error("Failing operation is not valid", berr.Index, berr.Operation, berr.Data)
+	}
+
+	expected := "batch operation 1 (get *neo4j.Node): 404 Not Found: NodeNotFoundException: Cannot find node with id [9] in database."
+	if err.Error() != expected {
+		t.Error("Error message is not valid", err)
+	}
+}
+
+func TestBatchExecuteFunc(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[`)
+		for i := 0; i < 2; i++ {
+			self := fmt.Sprintf("http://%s/db/data/node/%d", r.Host, i+3)
+			fmt.Fprintf(w, `{"id":%d,"from":"/node","location":"%s","body":{"self":"%s","data":{}},"status":201},`, i, self, self)
+		}
+		fmt.Fprint(w, `{"id":2,"from":"/node/9","body":{"message":"Cannot find node with id [9] in database.","exception":"NodeNotFoundException"},"status":404}]`)
+	}))
+	defer server.Close()
+
+	neo4jConnection := Connect(server.URL)
+
+	first, second := &Node{}, &Node{}
+	batch := neo4jConnection.NewBatch()
+	batch.Create(first)
+	batch.Create(second)
+	batch.Get(&Node{ID: "9"})
+
+	var ids []int
+	err := batch.ExecuteFunc(func(response *BatchResponse) error {
+		ids = append(ids, response.ID)
+		if response.ID == 0 && first.ID != "3" {
+			t.Error("Response is not mapped before it is passed", first.ID)
+		}
+
+		return nil
+	})
+
+	var berr *BatchError
+	if !errors.As(err, &berr) || berr.Index != 2 || !IsNotFound(err) {
+		t.Error("Failing operation is not reported", err)
+	}
+
+	if !reflect.DeepEqual(ids, []int{0, 1}) || second.ID != "4" {
+		t.Error("Responses are not passed as they arrive", ids, second.ID)
+	}
+
+	// fn stops reading the response
+	batch = neo4jConnection.NewBatch()
+	batch.Create(&Node{})
+	batch.Create(&Node{})
+
+	calls := 0
+	stop := errors.New("stop")
+	err = batch.ExecuteFunc(func(response *BatchResponse) error {
+		calls++
+		return stop
+	})
+
+	if err != stop || calls != 1 {
+		t.Error("Error of fn is not returned", err, calls)
+	}
+}
+
+func TestBatchReportsMappingErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":0,"from":"/node/3/labels","body":{},"status":200},`)
+		fmt.Fprint(w, `{"id":1,"from":"/node/4/labels","body":["Person"],"status":200}]`)
+	}))
+	defer server.Close()
+
+	batch := Connect(server.URL).NewBatch()
+	batch.GetLabels(&Node{ID: "3"})
+	valid := &Node{ID: "4"}
+	batch.GetLabels(valid)
+
+	res, err := batch.Execute()
+
+	var berr *BatchError
+	if !errors.As(err, &berr) || berr.Index != 0 {
+		t.Fatal("Mapping error is not reported", err)
+	}
+
+	if len(res) != 2 || res[0].Status != 200 || res[0].Error == nil || res[1].Error != nil {
+		t.Error("Responses are not valid", res)
+	}
+
+	if len(valid.Labels) != 1 {
+		t.Error("Operations after the failing one are not mapped", valid.Labels)
+	}
+
+	if len(batch.Stack) != 0 {
+		t.Error("Executed batch is not cleaned")
+	}
+}
+
+func TestBatchReportsInvalidOperations(t *testing.T) {
+	batch := Connect("").NewBatch()
+	batch.Create(createNewNode())
+	batch.AddLabels(&Node{ID: "1"})
+
+	_, err := batch.Execute()
+
+	var berr *BatchError
+	if !errors.As(err, &berr) {
+		t.Fatal("Error is not a BatchError", err)
+	}
+
+	if berr.Index != 1 || berr.Operation != BatchCreate {
+		t.Error("Failing operation is not valid", berr.Index, berr.Operation)
+	}
+
+	if _, ok := berr.Data.(*NodeLabels); !ok {
+		t.Error("Failing batcher is not valid", berr.Data)
+	}
+}
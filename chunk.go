@@ -0,0 +1,148 @@
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// batchReferencePattern matches the "{n}" placeholders in batch operations
+var batchReferencePattern = regexp.MustCompile(`\{(\d+)\}`)
+
+// ExecuteChunked is like Execute but sends the stack in chunks of given
+// size, it can be used for the batches which are too big to be executed in
+// one request. Every chunk is executed in its own transaction by Neo4j, so
+// if a chunk fails the previous ones are not rolled back; the returned
+// error is a *ChunkError telling which chunk has failed, and the responses
+// of the committed chunks are returned along with it.
+//
+// The "{n}" placeholders pointing to the operations of the previous chunks
+// are replaced with the locations of the entities returned by them, the
+// ones pointing to the same chunk are renumbered. Placeholders are replaced
+// in the urls and bodies of the operations, just like Neo4j does; the ones
+// in the bodies which point to an operation without a location are left
+// unchanged.
+//
+// Example usage;
+//
+//	batch := neo4jConnection.NewBatch()
+//	for _, node := range nodes {
+//		batch.Create(node)
+//	}
+//
+//	_, err := batch.ExecuteChunked(1000)
+//	var cerr *ChunkError
+//	if errors.As(err, &cerr) {
+//		fmt.Println("operations starting from", cerr.Start, "are not executed")
+//	}
+func (batch *Batch) ExecuteChunked(size int) ([]*BatchResponse, error) {
+	return batch.ExecuteChunkedContext(context.Background(), size)
+}
+
+// ExecuteChunkedContext is like ExecuteChunked but aborts the requests when
+// ctx is done
+func (batch *Batch) ExecuteChunkedContext(ctx context.Context, size int) ([]*BatchResponse, error) {
+	if batch.Neo4j == nil {
+		return nil, errors.New("Batch request is not created by NewBatch method!")
+	}
+
+	if size <= 0 {
+		return nil, errors.New("Chunk size must be positive")
+	}
+
+	return batch.execute(ctx, size, true, nil)
+}
+
+// rewriteReferences replaces the placeholders in the prepared operations of
+// the chunk starting at start, placeholders of the previous chunks are
+// replaced with the locations and the ones of the same chunk are renumbered
+func (batch *Batch) rewriteReferences(request []map[string]interface{}, start, end int, locations map[int]string) error {
+	basePath := ""
+	if u, err := url.Parse(batch.Neo4j.BaseURL); err == nil {
+		basePath = u.Path
+	}
+
+	for i, query := range request {
+		var err error
+		// strict reports the placeholders without a location, it is used
+		// for the urls of the operations which can not be sent without
+		// them. Neo4j leaves such placeholders in the bodies unchanged, eg:
+		// a property value like "{3}"
+		rewrite := func(s string, strict bool) string {
+			return batchReferencePattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+				index := referenceIndex(placeholder)
+				switch {
+				case index >= start && index < end:
+					return "{" + strconv.Itoa(index-start) + "}"
+				case index >= 0 && index < start:
+					location, ok := locations[index]
+					if !ok {
+						if strict {
+							err = fmt.Errorf("Operation %d refers to operation %d which did not return a location", start+i, index)
+						}
+						return placeholder
+					}
+
+					return locationPath(basePath, location)
+				}
+
+				return placeholder
+			})
+		}
+
+		if to, ok := query["to"].(string); ok {
+			query["to"] = rewrite(to, true)
+		}
+
+		if body, ok := query["body"]; ok {
+			query["body"] = rewriteStrings(body, func(s string) string {
+				return rewrite(s, false)
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rewriteStrings applies rewrite to all the strings in value
+func rewriteStrings(value interface{}, rewrite func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return rewrite(v)
+	case map[string]interface{}:
+		rewritten := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			rewritten[key] = rewriteStrings(item, rewrite)
+		}
+
+		return rewritten
+	case []interface{}:
+		rewritten := make([]interface{}, len(v))
+		for i, item := range v {
+			rewritten[i] = rewriteStrings(item, rewrite)
+		}
+
+		return rewritten
+	}
+
+	return value
+}
+
+// locationPath returns the location relative to the service root, eg:
+// /node/12, it is what Batchers use in batch operations
+func locationPath(basePath, location string) string {
+	u, err := url.Parse(location)
+	if err != nil || u.Path == "" {
+		return location
+	}
+
+	return strings.TrimPrefix(u.Path, basePath)
+}
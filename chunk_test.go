@@ -0,0 +1,189 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExecuteChunkedWithReferences(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	start := batch.CreateRef(createNewNode())
+	batch.Create(createNewNode())
+	end := batch.CreateRef(createNewNode())
+
+	relationship := &Relationship{
+		Type:        "CHUNK_TEST",
+		StartNodeID: start.ID(),
+		EndNodeID:   end.ID(),
+	}
+	batch.Create(relationship)
+
+	// manual requests are rewritten too
+	labels := &ManuelBatchRequest{
+		To:         "{0}/labels",
+		StringBody: "ChunkTest",
+	}
+	batch.Create(labels)
+
+	res, err := batch.ExecuteChunked(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 5 {
+		t.Fatal("Response length is not valid", len(res))
+	}
+
+	for i, val := range res {
+		if val.ID != i {
+			t.Error("Response id is not valid", i, val.ID)
+		}
+	}
+
+	if relationship.ID == "" {
+		t.Error("Relationship is not created")
+	}
+
+	if relationship.StartNodeID != start.ID() || relationship.EndNodeID != end.ID() {
+		t.Error("Relationship is not created between referenced nodes")
+	}
+
+	node := &Node{ID: start.ID()}
+	if _, err := neo4jConnection.NewBatch().GetLabels(node).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !hasLabel(node.Labels, "ChunkTest") {
+		t.Error("Label is not added to the referenced node", node.Labels)
+	}
+}
+
+func TestExecuteChunkedReportsFailingChunk(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	batch.Create(createNewNode())
+	batch.Create(createNewNode())
+	batch.Get(&Node{ID: "999999999"})
+
+	res, err := batch.ExecuteChunked(2)
+
+	var cerr *ChunkError
+	if !errors.As(err, &cerr) {
+		t.Fatal("Error is not a chunk error", err)
+	}
+
+	if cerr.Chunk != 1 || cerr.Start != 2 || cerr.End != 3 {
+		t.Error("Failing chunk is not valid", cerr.Chunk, cerr.Start, cerr.End)
+	}
+
+	if !IsNotFound(err) {
+		t.Error("Chunk error should wrap the error of the chunk", err)
+	}
+
+	if len(res) != 2 {
+		t.Error("Responses of the committed chunk should be returned", len(res))
+	}
+}
+
+func TestExecuteChunkedReportsSingleChunk(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":0,"from":"/node/9","body":{"message":"Cannot find node with id [9] in database.","exception":"NodeNotFoundException"},"status":404}]`)
+	}))
+	defer server.Close()
+
+	_, err := Connect(server.URL).NewBatch().Get(&Node{ID: "9"}).ExecuteChunked(10)
+
+	var cerr *ChunkError
+	if !errors.As(err, &cerr) || cerr.Chunk != 0 || cerr.Start != 0 || cerr.End != 1 {
+		t.Fatal("Error is not a chunk error", err)
+	}
+
+	if !IsNotFound(err) {
+		t.Error("Chunk error should wrap the error of the chunk", err)
+	}
+}
+
+func TestMaxBatchSize(t *testing.T) {
+	neo4jConnection := Connect("")
+	neo4jConnection.MaxBatchSize = 1
+	batch := neo4jConnection.NewBatch()
+
+	batch.Create(createNewNode())
+	batch.Get(&Node{ID: "999999999"})
+
+	_, err := batch.Execute()
+
+	var cerr *ChunkError
+	if !errors.As(err, &cerr) || cerr.Chunk != 1 {
+		t.Error("Batch is not executed in chunks", err)
+	}
+}
+
+func TestRewriteReferences(t *testing.T) {
+	batch := Connect("").NewBatch()
+
+	request := []map[string]interface{}{
+		{
+			"to":   "{1}/relationships",
+			"body": map[string]interface{}{"to": "{3}", "data": []interface{}{"{2}"}},
+		},
+		{
+			"to":   "/cypher",
+			"body": map[string]interface{}{"query": "{7}"},
+		},
+	}
+
+	locations := map[int]string{
+		1: "http://127.0.0.1:7474/db/data/node/12",
+	}
+
+	if err := batch.rewriteReferences(request, 2, 4, locations); err != nil {
+		t.Fatal(err)
+	}
+
+	if request[0]["to"] != "/node/12/relationships" {
+		t.Error("Previous chunk reference is not replaced", request[0]["to"])
+	}
+
+	body := request[0]["body"].(map[string]interface{})
+	if body["to"] != "{1}" {
+		t.Error("Same chunk reference is not renumbered", body["to"])
+	}
+
+	if body["data"].([]interface{})[0] != "{0}" {
+		t.Error("References in arrays are not renumbered", body["data"])
+	}
+
+	if request[1]["body"].(map[string]interface{})["query"] != "{7}" {
+		t.Error("Forward references should not be changed")
+	}
+
+	request = []map[string]interface{}{{"to": "{0}/labels"}}
+	if err := batch.rewriteReferences(request, 2, 3, locations); err == nil {
+		t.Error("Reference without location should return an error")
+	}
+
+	// a property value looking like a reference, operation 0 is a cypher
+	// query without a location
+	request = []map[string]interface{}{
+		{
+			"to":   "/node",
+			"body": map[string]interface{}{"code": "{0}", "owner": "{1}"},
+		},
+	}
+
+	if err := batch.rewriteReferences(request, 2, 3, locations); err != nil {
+		t.Fatal("Property without location should not return an error", err)
+	}
+
+	body = request[0]["body"].(map[string]interface{})
+	if body["code"] != "{0}" || body["owner"] != "/node/12" {
+		t.Error("Property references are not valid", body)
+	}
+}
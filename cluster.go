@@ -0,0 +1,288 @@
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoMaster is returned when none of the cluster members is the master
+var ErrNoMaster = errors.New("No master found in the cluster")
+
+// Cluster routes the requests to the members of a Neo4j HA cluster. Writes
+// are sent to the master which is discovered with the HA status endpoints,
+// reads are distributed to the slaves in round robin order.
+//
+// When a member stops responding it is not used for DownTimeout; reads are
+// sent to the next member, the master is discovered again for the next
+// write. Writes are never sent twice, since it is not known if the failed
+// one is applied.
+//
+// Batches created by NewBatch are routed by their chunks, a chunk is a read
+// if all of its operations are GETs.
+//
+// Example usage;
+//
+//	cluster, err := ConnectCluster([]string{
+//		"http://10.0.0.1:7474",
+//		"http://10.0.0.2:7474",
+//		"http://10.0.0.3:7474",
+//	})
+//
+//	node := &Node{Data: map[string]interface{}{"name": "alice"}}
+//	err = cluster.Create(node) // sent to master
+//	err = cluster.Get(node)    // sent to a slave
+type Cluster struct {
+	Members []*Neo4j
+
+	// DownTimeout is the time a member which stopped responding is not
+	// used, it is 10 seconds if not set
+	DownTimeout time.Duration
+
+	mu     sync.Mutex
+	master *Neo4j
+	down   map[*Neo4j]time.Time
+	next   int
+}
+
+// ConnectCluster connects to the members of the cluster with the given
+// options, see ConnectWithOptions
+func ConnectCluster(urls []string, opts ...Option) (*Cluster, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("Cluster urls are not given")
+	}
+
+	cluster := &Cluster{
+		down: make(map[*Neo4j]time.Time),
+	}
+
+	for _, url := range urls {
+		member, err := ConnectWithOptions(url, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		cluster.Members = append(cluster.Members, member)
+	}
+
+	return cluster, nil
+}
+
+// NewBatch creates a batch which is routed to the cluster members
+func (c *Cluster) NewBatch() *Batch {
+	batch := c.Members[0].NewBatch()
+	batch.cluster = c
+
+	return batch
+}
+
+// Get is like Neo4j.Get but sends the request to a slave
+func (c *Cluster) Get(obj Batcher) error {
+	return c.GetContext(context.Background(), obj)
+}
+
+// GetContext is like Get but aborts the request when ctx is done
+func (c *Cluster) GetContext(ctx context.Context, obj Batcher) error {
+	_, err := c.NewBatch().Get(obj).ExecuteContext(ctx)
+	return err
+}
+
+// Create is like Neo4j.Create but sends the request to the master
+func (c *Cluster) Create(obj Batcher) error {
+	return c.CreateContext(context.Background(), obj)
+}
+
+// CreateContext is like Create but aborts the request when ctx is done
+func (c *Cluster) CreateContext(ctx context.Context, obj Batcher) error {
+	_, err := c.NewBatch().Create(obj).ExecuteContext(ctx)
+	return err
+}
+
+// Update is like Neo4j.Update but sends the request to the master
+func (c *Cluster) Update(obj Batcher) error {
+	return c.UpdateContext(context.Background(), obj)
+}
+
+// UpdateContext is like Update but aborts the request when ctx is done
+func (c *Cluster) UpdateContext(ctx context.Context, obj Batcher) error {
+	_, err := c.NewBatch().Update(obj).ExecuteContext(ctx)
+	return err
+}
+
+// Delete is like Neo4j.Delete but sends the request to the master
+func (c *Cluster) Delete(obj Batcher) error {
+	return c.DeleteContext(context.Background(), obj)
+}
+
+// DeleteContext is like Delete but aborts the request when ctx is done
+func (c *Cluster) DeleteContext(ctx context.Context, obj Batcher) error {
+	_, err := c.NewBatch().Delete(obj).ExecuteContext(ctx)
+	return err
+}
+
+// Master returns the master of the cluster, it is discovered if not known
+func (c *Cluster) Master() (*Neo4j, error) {
+	return c.MasterContext(context.Background())
+}
+
+// MasterContext is like Master but aborts the requests when ctx is done
+func (c *Cluster) MasterContext(ctx context.Context) (*Neo4j, error) {
+	c.mu.Lock()
+	master := c.master
+	c.mu.Unlock()
+
+	if master != nil {
+		return master, nil
+	}
+
+	return c.discoverMaster(ctx)
+}
+
+// Discover asks the members which one is the master
+func (c *Cluster) Discover() error {
+	return c.DiscoverContext(context.Background())
+}
+
+// DiscoverContext is like Discover but aborts the requests when ctx is done
+func (c *Cluster) DiscoverContext(ctx context.Context) error {
+	_, err := c.discoverMaster(ctx)
+	return err
+}
+
+func (c *Cluster) discoverMaster(ctx context.Context) (*Neo4j, error) {
+	var lastErr error
+	for _, member := range c.Members {
+		isMaster, err := member.isMaster(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+
+			lastErr = err
+			c.markDown(member)
+			continue
+		}
+
+		if isMaster {
+			c.mu.Lock()
+			c.master = member
+			delete(c.down, member)
+			c.mu.Unlock()
+
+			return member, nil
+		}
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+
+	return nil, ErrNoMaster
+}
+
+// do calls fn with the master for writes, or with the readers until one of
+// them responds
+func (c *Cluster) do(ctx context.Context, write bool, fn func(member *Neo4j) error) error {
+	if write {
+		master, err := c.MasterContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = fn(master)
+		if err != nil && isUnavailable(err) {
+			c.markDown(master)
+		}
+
+		return err
+	}
+
+	var err error
+	for _, member := range c.readers() {
+		err = fn(member)
+		if err == nil || !isUnavailable(err) || ctx.Err() != nil {
+			return err
+		}
+
+		c.markDown(member)
+	}
+
+	return err
+}
+
+// readers returns the members in the order they should be tried for a
+// read; slaves in round robin order, the master and the members which are
+// down as the last resort
+func (c *Cluster) readers() []*Neo4j {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timeout := c.DownTimeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	var slaves, down []*Neo4j
+	for _, member := range c.Members {
+		if since, ok := c.down[member]; ok {
+			if time.Since(since) < timeout {
+				down = append(down, member)
+				continue
+			}
+
+			delete(c.down, member)
+		}
+
+		if member != c.master {
+			slaves = append(slaves, member)
+		}
+	}
+
+	readers := make([]*Neo4j, 0, len(c.Members))
+	if len(slaves) > 0 {
+		start := c.next % len(slaves)
+		c.next++
+
+		readers = append(readers, slaves[start:]...)
+		readers = append(readers, slaves[:start]...)
+	}
+
+	if c.master != nil && c.down[c.master].IsZero() {
+		readers = append(readers, c.master)
+	}
+
+	return append(readers, down...)
+}
+
+// markDown marks the member as not responding, the master is discovered
+// again if it is the master
+func (c *Cluster) markDown(member *Neo4j) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.down == nil {
+		c.down = make(map[*Neo4j]time.Time)
+	}
+
+	c.down[member] = time.Now()
+	if c.master == member {
+		c.master = nil
+	}
+}
+
+// isMaster asks the server if it is the master of its cluster
+func (neo4j *Neo4j) isMaster(ctx context.Context) (bool, error) {
+	response, err := neo4j.doRequest(ctx, "GET", neo4j.manageURL()+"/server/ha/master", "")
+	if err != nil {
+		// slaves respond with 404
+		if IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return strings.TrimSpace(response) == "true", nil
+}
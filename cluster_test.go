@@ -0,0 +1,151 @@
+package neo4j
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// fakeMember serves the HA status and batch endpoints of a cluster member
+type fakeMember struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	master  bool
+	batches int
+}
+
+func newFakeMember(master bool) *fakeMember {
+	member := &fakeMember{master: master}
+	member.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		member.mu.Lock()
+		defer member.mu.Unlock()
+
+		switch r.URL.Path {
+		case "/db/manage/server/ha/master":
+			if !member.master {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, "false")
+				return
+			}
+
+			fmt.Fprint(w, "true")
+		case "/db/data/batch":
+			member.batches++
+			self := "http://" + r.Host + "/db/data/node/1"
+			fmt.Fprintf(w, `[{"id":0,"from":"/node","body":{"self":"%s","data":{}},"status":200}]`, self)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return member
+}
+
+func (m *fakeMember) count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.batches
+}
+
+func TestClusterRouting(t *testing.T) {
+	master := newFakeMember(true)
+	defer master.Close()
+	slave1 := newFakeMember(false)
+	defer slave1.Close()
+	slave2 := newFakeMember(false)
+	defer slave2.Close()
+
+	cluster, err := ConnectCluster([]string{slave1.URL, master.URL, slave2.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cluster.Create(&Node{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if master.count() != 1 {
+		t.Error("Write is not sent to master")
+	}
+
+	for i := 0; i < 4; i++ {
+		if err := cluster.Get(&Node{ID: "1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if slave1.count() != 2 || slave2.count() != 2 || master.count() != 1 {
+		t.Error("Reads are not distributed to slaves", slave1.count(), slave2.count(), master.count())
+	}
+
+	// reads fail over to the next member
+	slave1.Close()
+	for i := 0; i < 2; i++ {
+		if err := cluster.Get(&Node{ID: "1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if slave2.count() != 4 {
+		t.Error("Reads are not failed over", slave2.count())
+	}
+}
+
+func TestClusterMasterFailover(t *testing.T) {
+	master := newFakeMember(true)
+	slave := newFakeMember(false)
+	defer slave.Close()
+
+	cluster, err := ConnectCluster([]string{master.URL, slave.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cluster.Discover(); err != nil {
+		t.Fatal(err)
+	}
+
+	master.Close()
+	slave.mu.Lock()
+	slave.master = true
+	slave.mu.Unlock()
+
+	// failed write is not sent again
+	if err := cluster.Create(&Node{}); err == nil {
+		t.Fatal("Write to stopped master should fail")
+	}
+
+	if slave.count() != 0 {
+		t.Error("Failed write is sent again")
+	}
+
+	if err := cluster.Create(&Node{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if slave.count() != 1 {
+		t.Error("New master is not discovered")
+	}
+}
+
+func TestClusterWithoutMaster(t *testing.T) {
+	slave := newFakeMember(false)
+	defer slave.Close()
+
+	cluster, err := ConnectCluster([]string{slave.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cluster.Create(&Node{}); err != ErrNoMaster {
+		t.Error("Write without master should fail", err)
+	}
+
+	if _, err := ConnectCluster(nil); err == nil {
+		t.Error("Cluster without members should not be created")
+	}
+}
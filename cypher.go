@@ -1,22 +1,68 @@
 package neo4j
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
 
-// Cypher struct
+// Cypher struct is a Cypher query which can be sent within a Batch
+//
+// Example usage;
+//
+//	cypher := &Cypher{
+//		Statement: "START n=node({id}) RETURN n, n.name",
+//		Params:    map[string]interface{}{"id": 3},
+//	}
+//	neo4jConnection.NewBatch().Create(cypher).Execute()
+//	node := cypher.Result.Rows[0][0].(*Node)
 type Cypher struct {
-	Query   map[string]string
+	// Query is the raw request body, eg: {"query": "..."}, it is used if
+	// Statement is empty
+	Query map[string]string
+	// Payload is the raw response body
 	Payload interface{}
+
+	Statement string
+	Params    map[string]interface{}
+	// Result is set after the batch is executed
+	Result *Result
 }
 
-// CypherResponse struct for the Neo4J cyhpher query response
+// CypherResponse struct for the Neo4J cyhpher query response, every row of
+// Data has a value for each of the Columns
 type CypherResponse struct {
-	Columns map[string]interface{} `json:"columns"`
-	Data    map[string]interface{} `json:"data"`
+	Columns []string        `json:"columns"`
+	Data    [][]interface{} `json:"data"`
+}
+
+// Cypher runs the query with given parameters and returns its rows, nodes
+// and relationships in the rows are returned as *Node and *Relationship
+func (neo4j *Neo4j) Cypher(query string, params map[string]interface{}) (*Result, error) {
+	return neo4j.CypherContext(context.Background(), query, params)
+}
+
+// CypherContext is like Cypher but aborts the request when ctx is done
+func (neo4j *Neo4j) CypherContext(ctx context.Context, query string, params map[string]interface{}) (*Result, error) {
+	cypher := &Cypher{
+		Statement: query,
+		Params:    params,
+	}
+
+	if _, err := neo4j.NewBatch().Create(cypher).ExecuteContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return cypher.Result, nil
 }
 
 func (c *Cypher) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error) {
 	encodedData, err := jsonEncode(data)
-	err = c.decodeResponse(encodedData)
+	if err != nil {
+		return false, err
+	}
+
+	err = c.decodeResponse(neo4j, encodedData)
 	if err != nil {
 		return false, err
 	}
@@ -25,30 +71,44 @@ func (c *Cypher) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error)
 }
 
 func (c *Cypher) getBatchQuery(operation string) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+
+	if c.Statement != "" {
+		body["query"] = c.Statement
+	} else {
+		for key, value := range c.Query {
+			body[key] = value
+		}
+	}
+
+	if body["query"] == nil {
+		return map[string]interface{}{}, errors.New("Query is not given")
+	}
+
+	if len(c.Params) > 0 {
+		body["params"] = c.Params
+	}
+
 	return map[string]interface{}{
 		"method": "POST",
 		"to":     "/cypher",
-		"body":   c.Query,
+		"body":   body,
 	}, nil
 }
 
-func (c *Cypher) decodeResponse(data string) error {
-	resp := map[string]interface{}{}
-
-	err := json.Unmarshal([]byte(data), &resp)
+func (c *Cypher) decodeResponse(neo4j *Neo4j, data string) error {
+	err := json.Unmarshal([]byte(data), &c.Payload)
 	if err != nil {
 		return err
 	}
 
-	jsonizedData, err := json.Marshal(resp)
+	payload := &CypherResponse{}
+	err = json.Unmarshal([]byte(data), payload)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(jsonizedData, &c.Payload)
-	if err != nil {
-		return err
-	}
+	c.Result = newResult(neo4j, payload.Columns, payload.Data)
 
 	return nil
 }
@@ -60,3 +60,161 @@ func TestSendCypherQueryWithNoResults(t *testing.T) {
 		t.Error("Got cypher results")
 	}
 }
+
+func TestCypherStatementInBatch(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	cypher := &Cypher{
+		Statement: "CREATE (k {name: {name}}) RETURN k, k.name AS name",
+		Params:    map[string]interface{}{"name": "cypherStatement"},
+	}
+
+	if _, err := neo4jConnection.NewBatch().Create(cypher).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if cypher.Payload.(map[string]interface{})["data"] == nil {
+		t.Error("no data")
+	}
+
+	if cypher.Result.Len() != 1 || cypher.Result.ColumnIndex("name") != 1 {
+		t.Fatal("result is not valid", cypher.Result)
+	}
+
+	if node, ok := cypher.Result.Rows[0][0].(*Node); !ok || node.Data["name"] != "cypherStatement" {
+		t.Error("node is not converted", cypher.Result.Rows[0][0])
+	}
+}
+
+func TestCypherBatchQuery(t *testing.T) {
+	query, err := (&Cypher{Query: map[string]string{"query": "RETURN 1"}}).getBatchQuery(BatchCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query["body"].(map[string]interface{})["query"] != "RETURN 1" {
+		t.Error("raw query is not sent", query["body"])
+	}
+
+	cypher := &Cypher{
+		Query:     map[string]string{"query": "RETURN 1"},
+		Statement: "RETURN {x}",
+		Params:    map[string]interface{}{"x": 2},
+	}
+
+	query, err = cypher.getBatchQuery(BatchCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := query["body"].(map[string]interface{})
+	if body["query"] != "RETURN {x}" || body["params"] == nil {
+		t.Error("statement is not sent", body)
+	}
+
+	if _, err := (&Cypher{}).getBatchQuery(BatchCreate); err == nil {
+		t.Error("empty query is accepted")
+	}
+}
+
+func TestCypherWithParamsReturnsNodesAndRelationships(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	res, err := neo4jConnection.Cypher(`
+        CREATE (a {name: {name}, scores: {scores}})-[r:KNOWS {since: {since}}]->(b)
+        RETURN a, r, b, a.scores AS scores
+		  `, map[string]interface{}{
+		"name":   "cypherParams",
+		"scores": []int{1, 2, 3},
+		"since":  2015,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"a", "r", "b", "scores"}
+	if len(res.Columns) != len(expected) {
+		t.Fatal("columns are not valid", res.Columns)
+	}
+
+	for i, column := range expected {
+		if res.Columns[i] != column {
+			t.Error("column order is not valid", res.Columns)
+		}
+	}
+
+	if res.Len() != 1 {
+		t.Fatal(res.Len(), "row count is not valid")
+	}
+
+	a, ok := res.Rows[0][0].(*Node)
+	if !ok {
+		t.Fatal("node is not converted", res.Rows[0][0])
+	}
+
+	if a.ID == "" || a.Data["name"] != "cypherParams" {
+		t.Error("node is not valid", a)
+	}
+
+	r, ok := res.Rows[0][1].(*Relationship)
+	if !ok {
+		t.Fatal("relationship is not converted", res.Rows[0][1])
+	}
+
+	b := res.Rows[0][2].(*Node)
+	if r.StartNodeID != a.ID || r.EndNodeID != b.ID || r.Type != "KNOWS" {
+		t.Error("relationship is not valid", r)
+	}
+
+	if r.Data["since"] != float64(2015) {
+		t.Error("relationship data is not valid", r.Data)
+	}
+
+	if scores, ok := res.Rows[0][3].([]interface{}); !ok || len(scores) != 3 {
+		t.Error("array property is not valid", res.Rows[0][3])
+	}
+}
+
+func TestConvertValue(t *testing.T) {
+	neo4jConnection := Connect("")
+	nodeURL := neo4jConnection.NodeURL
+
+	node := map[string]interface{}{
+		"self":                   nodeURL + "/4",
+		"outgoing_relationships": nodeURL + "/4/relationships/out",
+		"data":                   map[string]interface{}{"name": "converted"},
+	}
+
+	relationship := map[string]interface{}{
+		"self":  neo4jConnection.RelationshipURL + "/7",
+		"start": nodeURL + "/4",
+		"end":   nodeURL + "/5",
+		"type":  "KNOWS",
+		"data":  map[string]interface{}{},
+	}
+
+	value := convertValue(neo4jConnection, []interface{}{
+		node,
+		relationship,
+		map[string]interface{}{"self": "not a node"},
+		"string",
+	})
+
+	values := value.([]interface{})
+
+	if n, ok := values[0].(*Node); !ok || n.ID != "4" || n.Data["name"] != "converted" {
+		t.Error("node is not converted", values[0])
+	}
+
+	if r, ok := values[1].(*Relationship); !ok || r.ID != "7" || r.StartNodeID != "4" || r.EndNodeID != "5" {
+		t.Error("relationship is not converted", values[1])
+	}
+
+	if _, ok := values[2].(map[string]interface{}); !ok {
+		t.Error("map is converted", values[2])
+	}
+
+	if values[3] != "string" {
+		t.Error("scalar value is changed", values[3])
+	}
+}
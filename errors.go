@@ -0,0 +1,268 @@
+package neo4j
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Neo4jError is returned when Neo4j responds with an unexpected status code.
+// It carries the error details the server sends in the response body.
+//
+// Use errors.As to get hold of it;
+//
+//	var nerr *Neo4jError
+//	if errors.As(err, &nerr) {
+//		fmt.Println(nerr.Exception, nerr.Message)
+//	}
+type Neo4jError struct {
+	// StatusCode and Status are taken from the HTTP response
+	StatusCode int
+	Status     string
+
+	// Code is the Neo4j status code, eg: Neo.ClientError.Schema.ConstraintViolation
+	// It is only sent by the newer endpoints
+	Code string
+
+	// Exception is the short name of the server side exception class,
+	// FullName is the fully qualified one
+	Exception  string
+	FullName   string
+	Message    string
+	StackTrace []string
+
+	// PasswordChange is the url to change the password of the user when
+	// the password must be changed, see ErrPasswordChangeRequired
+	PasswordChange string
+
+	// Index is the id of the failing batch operation, -1 if the error is
+	// not related with a specific batch operation or the server did not
+	// tell which one has failed
+	Index int
+}
+
+// ChunkError is returned when a chunk of a batch executed in chunks fails.
+// The chunks before it are already committed by Neo4j, the operations
+// from Start to End (exclusive) and the ones after them are not executed.
+// Err is the error of the failing chunk.
+type ChunkError struct {
+	Chunk int
+	Start int
+	End   int
+	Err   error
+}
+
+// Error implements error interface
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("batch chunk %d (operations %d-%d): %s", e.Chunk, e.Start, e.End-1, e.Err)
+}
+
+// Unwrap returns the error of the failing chunk
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// errorResponse is the body Neo4j sends along with an error status
+type errorResponse struct {
+	Message    string   `json:"message"`
+	Exception  string   `json:"exception"`
+	FullName   string   `json:"fullname"`
+	StackTrace []string `json:"stacktrace"`
+	// PasswordChange is sent along with 401 and 403 when the password is
+	// expired
+	PasswordChange string `json:"password_change"`
+	Errors         []struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// BatchError is returned by Execute when an operation of the batch fails,
+// either on the server or while mapping its result. Err is the error of the
+// operation, a *Neo4jError if the server reports one. If the results of
+// several operations can not be mapped only the first one is returned, the
+// errors of all operations are in BatchResponse.Error.
+type BatchError struct {
+	// Index is the index of the operation in the batch
+	Index int
+
+	// Operation is one of BatchGet, BatchCreate, BatchDelete, BatchUpdate
+	// and BatchCreateUnique
+	Operation string
+
+	// Data is the Batcher given to the batch
+	Data Batcher
+
+	Err error
+}
+
+// Error implements error interface
+func (e *BatchError) Error() string {
+	msg := e.Err.Error()
+
+	// index is already in the message of the Neo4jError
+	var nerr *Neo4jError
+	if errors.As(e.Err, &nerr) && nerr.Index >= 0 {
+		msg = nerr.message()
+	}
+
+	return fmt.Sprintf("batch operation %d (%s %T): %s", e.Index, e.Operation, e.Data, msg)
+}
+
+// Unwrap returns the error of the operation
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// Error implements error interface
+func (e *Neo4jError) Error() string {
+	if e.Index >= 0 {
+		return fmt.Sprintf("batch operation %d: %s", e.Index, e.message())
+	}
+
+	return e.message()
+}
+
+// Is makes the errors requiring a password change match
+// ErrPasswordChangeRequired
+func (e *Neo4jError) Is(target error) bool {
+	return target == ErrPasswordChangeRequired && e.PasswordChange != ""
+}
+
+// message returns the error message without the batch operation index
+func (e *Neo4jError) message() string {
+	name := e.Exception
+	if name == "" {
+		name = e.Code
+	}
+
+	msg := e.Status
+	if msg == "" {
+		msg = fmt.Sprintf("%d", e.StatusCode)
+	}
+
+	if name != "" {
+		msg = fmt.Sprintf("%s: %s", msg, name)
+	}
+
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+
+	return msg
+}
+
+// IsNotFound reports whether err is a Neo4jError caused by a missing
+// node, relationship, index or any other resource
+func IsNotFound(err error) bool {
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		return false
+	}
+
+	return nerr.StatusCode == http.StatusNotFound ||
+		strings.HasSuffix(nerr.Exception, "NotFoundException") ||
+		nerr.Code == "Neo.ClientError.Statement.EntityNotFound"
+}
+
+// IsConstraintViolation reports whether err is a Neo4jError caused by a
+// violated constraint, eg: an already existing unique entity
+func IsConstraintViolation(err error) bool {
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		return false
+	}
+
+	return nerr.StatusCode == http.StatusConflict ||
+		nerr.Exception == "ConstraintViolationException" ||
+		strings.HasPrefix(nerr.Code, "Neo.ClientError.Schema.Constraint")
+}
+
+// IsTransient reports whether err is a Neo4jError caused by a temporary
+// problem like a deadlock, the failed operation can be retried
+func IsTransient(err error) bool {
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		return false
+	}
+
+	return nerr.Exception == "DeadlockDetectedException" ||
+		strings.HasPrefix(nerr.Code, "Neo.TransientError.")
+}
+
+// newError creates a Neo4jError from the given response, response body is
+// consumed but not closed
+func newError(res *http.Response) error {
+	nerr := &Neo4jError{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Index:      -1,
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nerr
+	}
+
+	nerr.decode(body)
+
+	return nerr
+}
+
+// newBatchError creates a Neo4jError from the failing operation of a
+// streamed batch response
+func newBatchError(response *BatchResponse) *Neo4jError {
+	nerr := &Neo4jError{
+		StatusCode: response.Status,
+		Status:     fmt.Sprintf("%d %s", response.Status, http.StatusText(response.Status)),
+		Index:      response.ID,
+	}
+
+	body, err := json.Marshal(response.Body)
+	if err != nil {
+		return nerr
+	}
+
+	nerr.decode(body)
+
+	return nerr
+}
+
+// decode fills the error details from the given response body, bodies which
+// are not in Neo4j error format are ignored
+func (e *Neo4jError) decode(body []byte) {
+	resp := &errorResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return
+	}
+
+	e.Message = resp.Message
+	e.Exception = resp.Exception
+	e.FullName = resp.FullName
+	e.StackTrace = resp.StackTrace
+	e.PasswordChange = resp.PasswordChange
+
+	if len(resp.Errors) > 0 {
+		e.Code = resp.Errors[0].Code
+		if e.Message == "" {
+			e.Message = resp.Errors[0].Message
+		}
+	}
+
+	// failed batch operations are wrapped into BatchOperationFailedException
+	// and the message holds the original error response
+	if e.Exception == "BatchOperationFailedException" {
+		inner := &Neo4jError{Index: e.Index}
+		inner.decode([]byte(e.Message))
+		if inner.Exception != "" || inner.Code != "" {
+			e.Message = inner.Message
+			e.Exception = inner.Exception
+			e.FullName = inner.FullName
+			e.StackTrace = inner.StackTrace
+			e.Code = inner.Code
+		}
+	}
+}
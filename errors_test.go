@@ -0,0 +1,133 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newErrorResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestErrorDecodesResponseBody(t *testing.T) {
+	err := newError(newErrorResponse(404, `{
+		"message" : "Cannot find node with id [42] in database.",
+		"exception" : "NodeNotFoundException",
+		"fullname" : "org.neo4j.server.rest.web.NodeNotFoundException",
+		"stacktrace" : [ "org.neo4j.server.rest.web.DatabaseActions.node(DatabaseActions.java:174)" ]
+	}`))
+
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		t.Fatal("Error is not a Neo4jError", err)
+	}
+
+	if nerr.StatusCode != 404 {
+		t.Error("Status code is not valid", nerr.StatusCode)
+	}
+
+	if nerr.Exception != "NodeNotFoundException" {
+		t.Error("Exception is not valid", nerr.Exception)
+	}
+
+	if nerr.FullName != "org.neo4j.server.rest.web.NodeNotFoundException" {
+		t.Error("Full name is not valid", nerr.FullName)
+	}
+
+	if len(nerr.StackTrace) != 1 {
+		t.Error("Stack trace is not valid", nerr.StackTrace)
+	}
+
+	if nerr.Index != -1 {
+		t.Error("Index must be -1", nerr.Index)
+	}
+
+	if !IsNotFound(err) {
+		t.Error("Error must be not found")
+	}
+
+	if IsConstraintViolation(err) {
+		t.Error("Error must not be constraint violation")
+	}
+}
+
+func TestErrorUnwrapsBatchOperationFailure(t *testing.T) {
+	err := newError(newErrorResponse(500, `{
+		"message" : "{\"message\" : \"Node 1 already exists\", \"exception\" : \"ConstraintViolationException\"}",
+		"exception" : "BatchOperationFailedException",
+		"fullname" : "org.neo4j.server.rest.domain.BatchOperationFailedException"
+	}`))
+
+	if !IsConstraintViolation(err) {
+		t.Error("Error must be constraint violation", err)
+	}
+
+	if !strings.Contains(err.Error(), "Node 1 already exists") {
+		t.Error("Error message is not valid", err)
+	}
+}
+
+func TestBatchErrorWithWrappedNeo4jError(t *testing.T) {
+	nerr := &Neo4jError{StatusCode: 404, Status: "404 Not Found", Message: "Node not found", Index: 1}
+
+	berr := &BatchError{
+		Index:     1,
+		Operation: BatchGet,
+		Data:      &Node{},
+		Err:       fmt.Errorf("reading node: %w", nerr),
+	}
+
+	expected := "batch operation 1 (get *neo4j.Node): 404 Not Found: Node not found"
+	if berr.Error() != expected {
+		t.Error("Error message is not valid", berr.Error())
+	}
+
+	berr.Err = nerr
+	if berr.Error() != expected {
+		t.Error("Error message is not valid", berr.Error())
+	}
+}
+
+func TestErrorWithNonJSONBody(t *testing.T) {
+	err := newError(newErrorResponse(502, "Bad Gateway"))
+
+	if err.Error() != "502 Bad Gateway" {
+		t.Error("Error message is not valid", err)
+	}
+
+	if IsNotFound(err) || IsConstraintViolation(err) {
+		t.Error("Error must not be classified")
+	}
+
+	if IsNotFound(errors.New("404 Not Found")) {
+		t.Error("Plain errors must not be classified")
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	err := newError(newErrorResponse(500, `{
+		"message" : "Deadlock detected",
+		"exception" : "DeadlockDetectedException"
+	}`))
+
+	if !IsTransient(err) {
+		t.Error("Deadlock must be transient", err)
+	}
+
+	err = newError(newErrorResponse(400, `{"errors" : [{"code" : "Neo.TransientError.Transaction.LockClientStopped", "message" : "stopped"}]}`))
+	if !IsTransient(err) {
+		t.Error("Transient status code must be transient", err)
+	}
+
+	if IsTransient(newError(newErrorResponse(503, ""))) {
+		t.Error("Unavailable server is not transient")
+	}
+}
@@ -1,8 +1,10 @@
 package neo4j
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/url"
 )
 
 // Index struct
@@ -11,13 +13,218 @@ type Index struct {
 	Config map[string]interface{}
 }
 
+// IndexEntry struct is used to add an entity into a legacy index or remove
+// it from the index within a Batch. Only one of Node and Relationship
+// should be set.
+//
+// Operations;
+//
+//	BatchCreate adds the entity to the index with Key and Value
+//	BatchDelete removes the entity from the index, if Value is nil all
+//	            entries with Key are removed, if Key is also empty all
+//	            entries of the entity are removed
+type IndexEntry struct {
+	Index        string
+	Key          string
+	Value        interface{}
+	Node         *Node
+	Relationship *Relationship
+}
+
 // CreateNodeIndex func
 func (neo4j *Neo4j) CreateNodeIndex(index *Index) error {
-	return neo4j.CreateIndex(index)
+	return neo4j.CreateIndexContext(context.Background(), index)
+}
+
+// CreateNodeIndexContext is like CreateNodeIndex but aborts the request when
+// ctx is done
+func (neo4j *Neo4j) CreateNodeIndexContext(ctx context.Context, index *Index) error {
+	return neo4j.CreateIndexContext(ctx, index)
 }
 
 // CreateIndex is here for backward compatibility
 func (neo4j *Neo4j) CreateIndex(index *Index) error {
+	return neo4j.CreateIndexContext(context.Background(), index)
+}
+
+// CreateIndexContext is like CreateIndex but aborts the request when ctx is
+// done
+func (neo4j *Neo4j) CreateIndexContext(ctx context.Context, index *Index) error {
+	return neo4j.createIndex(ctx, neo4j.IndexNodeURL, index)
+}
+
+// CreateRelationshipIndex creates a legacy relationship index
+func (neo4j *Neo4j) CreateRelationshipIndex(index *Index) error {
+	return neo4j.CreateRelationshipIndexContext(context.Background(), index)
+}
+
+// CreateRelationshipIndexContext is like CreateRelationshipIndex but aborts
+// the request when ctx is done
+func (neo4j *Neo4j) CreateRelationshipIndexContext(ctx context.Context, index *Index) error {
+	return neo4j.createIndex(ctx, neo4j.IndexRelationshipURL, index)
+}
+
+// DeleteIndex func
+func (neo4j *Neo4j) DeleteIndex(name string) error {
+	return neo4j.DeleteIndexContext(context.Background(), name)
+}
+
+// DeleteIndexContext is like DeleteIndex but aborts the request when ctx is
+// done
+func (neo4j *Neo4j) DeleteIndexContext(ctx context.Context, name string) error {
+	return neo4j.deleteIndex(ctx, neo4j.IndexNodeURL, name)
+}
+
+// DeleteRelationshipIndex deletes a legacy relationship index
+func (neo4j *Neo4j) DeleteRelationshipIndex(name string) error {
+	return neo4j.DeleteRelationshipIndexContext(context.Background(), name)
+}
+
+// DeleteRelationshipIndexContext is like DeleteRelationshipIndex but aborts
+// the request when ctx is done
+func (neo4j *Neo4j) DeleteRelationshipIndexContext(ctx context.Context, name string) error {
+	return neo4j.deleteIndex(ctx, neo4j.IndexRelationshipURL, name)
+}
+
+// IndexAdd adds the node into the index with given key and value as batch.
+// The node can be created in the same batch, see Reference
+func (batch *Batch) IndexAdd(index, key string, value interface{}, node *Node) *Batch {
+	batch.addToStack(BatchCreate, &IndexEntry{
+		Index: index,
+		Key:   key,
+		Value: value,
+		Node:  node,
+	})
+
+	return batch
+}
+
+// IndexRemove removes the node from the index as batch, see IndexEntry for
+// the usage of key and value
+func (batch *Batch) IndexRemove(index, key string, value interface{}, node *Node) *Batch {
+	batch.addToStack(BatchDelete, &IndexEntry{
+		Index: index,
+		Key:   key,
+		Value: value,
+		Node:  node,
+	})
+
+	return batch
+}
+
+// IndexGet queries the nodes in the index with exact key and value
+func (neo4j *Neo4j) IndexGet(index, key string, value interface{}) ([]Node, error) {
+	return neo4j.IndexGetContext(context.Background(), index, key, value)
+}
+
+// IndexGetContext is like IndexGet but aborts the request when ctx is done
+func (neo4j *Neo4j) IndexGetContext(ctx context.Context, index, key string, value interface{}) ([]Node, error) {
+	to, err := indexGetPath("node", index, key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Node{}
+	if err := neo4j.getFromIndex(ctx, to, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// IndexQuery queries the nodes in the index with the given Lucene query,
+// eg: "name:Jo* AND age:[20 TO 30]"
+func (neo4j *Neo4j) IndexQuery(index, query string) ([]Node, error) {
+	return neo4j.IndexQueryContext(context.Background(), index, query)
+}
+
+// IndexQueryContext is like IndexQuery but aborts the request when ctx is
+// done
+func (neo4j *Neo4j) IndexQueryContext(ctx context.Context, index, query string) ([]Node, error) {
+	to, err := indexQueryPath("node", index, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Node{}
+	if err := neo4j.getFromIndex(ctx, to, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RelationshipIndexAdd adds the relationship into the index with given key
+// and value as batch
+func (batch *Batch) RelationshipIndexAdd(index, key string, value interface{}, r *Relationship) *Batch {
+	batch.addToStack(BatchCreate, &IndexEntry{
+		Index:        index,
+		Key:          key,
+		Value:        value,
+		Relationship: r,
+	})
+
+	return batch
+}
+
+// RelationshipIndexRemove removes the relationship from the index as batch,
+// see IndexEntry for the usage of key and value
+func (batch *Batch) RelationshipIndexRemove(index, key string, value interface{}, r *Relationship) *Batch {
+	batch.addToStack(BatchDelete, &IndexEntry{
+		Index:        index,
+		Key:          key,
+		Value:        value,
+		Relationship: r,
+	})
+
+	return batch
+}
+
+// RelationshipIndexGet queries the relationships in the index with exact
+// key and value
+func (neo4j *Neo4j) RelationshipIndexGet(index, key string, value interface{}) ([]Relationship, error) {
+	return neo4j.RelationshipIndexGetContext(context.Background(), index, key, value)
+}
+
+// RelationshipIndexGetContext is like RelationshipIndexGet but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) RelationshipIndexGetContext(ctx context.Context, index, key string, value interface{}) ([]Relationship, error) {
+	to, err := indexGetPath("relationship", index, key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Relationship{}
+	if err := neo4j.getFromIndex(ctx, to, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RelationshipIndexQuery queries the relationships in the index with the
+// given Lucene query, eg: "name:Jo*"
+func (neo4j *Neo4j) RelationshipIndexQuery(index, query string) ([]Relationship, error) {
+	return neo4j.RelationshipIndexQueryContext(context.Background(), index, query)
+}
+
+// RelationshipIndexQueryContext is like RelationshipIndexQuery but aborts
+// the request when ctx is done
+func (neo4j *Neo4j) RelationshipIndexQueryContext(ctx context.Context, index, query string) ([]Relationship, error) {
+	to, err := indexQueryPath("relationship", index, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Relationship{}
+	if err := neo4j.getFromIndex(ctx, to, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (neo4j *Neo4j) createIndex(ctx context.Context, indexURL string, index *Index) error {
 	if index.Name == "" {
 		return errors.New("Name must be set!")
 	}
@@ -34,15 +241,141 @@ func (neo4j *Neo4j) CreateIndex(index *Index) error {
 		postData = fmt.Sprintf(`{"name" : "%s" }`, index.Name)
 	}
 
-	_, err := neo4j.doRequest("POST", neo4j.IndexNodeURL, postData)
+	_, err := neo4j.doRequest(ctx, "POST", indexURL, postData)
 	return err
 }
 
-// DeleteIndex func
-func (neo4j *Neo4j) DeleteIndex(name string) error {
-	url := neo4j.IndexNodeURL + "/" + name
-
-	//if node not found Neo4j returns 404
-	_, err := neo4j.doRequest("DELETE", url, "")
+func (neo4j *Neo4j) deleteIndex(ctx context.Context, indexURL, name string) error {
+	//if index not found Neo4j returns 404
+	_, err := neo4j.doRequest(ctx, "DELETE", indexURL+"/"+url.PathEscape(name), "")
 	return err
 }
+
+// getFromIndex sends the index lookup as a batch and maps the result into
+// *[]Node or *[]Relationship
+func (neo4j *Neo4j) getFromIndex(ctx context.Context, to string, result interface{}) error {
+	customReq := &ManuelBatchRequest{}
+	customReq.To = to
+
+	if _, err := neo4j.NewBatch().Get(customReq).ExecuteContext(ctx); err != nil {
+		return err
+	}
+
+	return neo4j.GetManualBatchResponse(customReq, result)
+}
+
+func indexGetPath(kind, index, key string, value interface{}) (string, error) {
+	if index == "" || key == "" {
+		return "", errors.New("Index name and key must be set")
+	}
+
+	return fmt.Sprintf("/index/%s/%s/%s/%s",
+		kind,
+		url.PathEscape(index),
+		url.PathEscape(key),
+		url.PathEscape(fmt.Sprint(value)),
+	), nil
+}
+
+func indexQueryPath(kind, index, query string) (string, error) {
+	if index == "" || query == "" {
+		return "", errors.New("Index name and query must be set")
+	}
+
+	params := url.Values{}
+	params.Set("query", query)
+
+	return fmt.Sprintf("/index/%s/%s?%s", kind, url.PathEscape(index), params.Encode()), nil
+}
+
+// Implement Batcher interface
+func (ie *IndexEntry) getBatchQuery(operation string) (map[string]interface{}, error) {
+	query := make(map[string]interface{})
+
+	if ie.Index == "" {
+		return query, errors.New("Index name is empty")
+	}
+
+	kind, id, entityPath, err := ie.entity()
+	if err != nil {
+		return query, err
+	}
+
+	indexPath := fmt.Sprintf("/index/%s/%s", kind, url.PathEscape(ie.Index))
+
+	switch operation {
+	case BatchCreate:
+		if ie.Key == "" || ie.Value == nil {
+			return query, errors.New("Key and value must be set")
+		}
+
+		query["method"] = "POST"
+		query["to"] = indexPath
+		query["body"] = map[string]interface{}{
+			"uri":   entityPath,
+			"key":   ie.Key,
+			"value": ie.Value,
+		}
+	case BatchDelete:
+		if isBatchReference(id) {
+			return query, errors.New("Entities created in the same batch can not be removed from index")
+		}
+
+		to := indexPath
+		if ie.Key != "" {
+			to += "/" + url.PathEscape(ie.Key)
+			if ie.Value != nil {
+				to += "/" + url.PathEscape(fmt.Sprint(ie.Value))
+			}
+		}
+
+		query["method"] = "DELETE"
+		query["to"] = to + "/" + id
+	default:
+		return query, fmt.Errorf("Operation %s is not supported for index entries", operation)
+	}
+
+	return query, nil
+}
+
+// entity returns the kind, id and batch path of the indexed entity
+func (ie *IndexEntry) entity() (kind, id, path string, err error) {
+	if ie.Node != nil && ie.Relationship != nil {
+		return "", "", "", errors.New("Only one of node and relationship can be indexed at once")
+	}
+
+	if ie.Node != nil {
+		if ie.Node.ID == "" {
+			return "", "", "", errors.New("Node Id not valid")
+		}
+
+		return "node", ie.Node.ID, nodePath(ie.Node.ID), nil
+	}
+
+	if ie.Relationship != nil {
+		if ie.Relationship.ID == "" {
+			return "", "", "", errors.New("Relationship Id not valid")
+		}
+
+		return "relationship", ie.Relationship.ID, relationshipPath(ie.Relationship.ID), nil
+	}
+
+	return "", "", "", errors.New("Indexed entity is not given")
+}
+
+func (ie *IndexEntry) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error) {
+	// only adding returns the indexed entity
+	if data == nil {
+		return true, nil
+	}
+
+	if ie.Node != nil {
+		return ie.Node.mapBatchResponse(neo4j, data)
+	}
+
+	if ie.Relationship != nil {
+		return ie.Relationship.mapBatchResponse(neo4j, data)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,182 @@
+package neo4j
+
+import (
+	"testing"
+)
+
+func TestRelationshipIndex(t *testing.T) {
+	neo4jConnection := Connect("")
+	indexName := uniqueLabel("relationshipIndexTest")
+
+	if err := neo4jConnection.CreateRelationshipIndex(&Index{Name: indexName}); err != nil {
+		t.Fatal(err)
+	}
+	defer neo4jConnection.DeleteRelationshipIndex(indexName)
+
+	node := createNewNode()
+	node2 := createNewNode()
+	if _, err := neo4jConnection.NewBatch().Create(node).Create(node2).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	relationship := crateRelationship(neo4jConnection, node, node2)
+	relationship2 := crateRelationship(neo4jConnection, node2, node)
+	if _, err := neo4jConnection.NewBatch().Create(relationship).Create(relationship2).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := neo4jConnection.NewBatch().
+		RelationshipIndexAdd(indexName, "name", "first", relationship).
+		RelationshipIndexAdd(indexName, "name", "second", relationship2).
+		Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := neo4jConnection.RelationshipIndexGet(indexName, "name", "first")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res) != 1 || res[0].ID != relationship.ID {
+		t.Error("Exact lookup is not valid", res)
+	}
+
+	res, err = neo4jConnection.RelationshipIndexQuery(indexName, "name:*")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res) != 2 {
+		t.Error(len(res), "Query lookup is not valid")
+	}
+
+	_, err = neo4jConnection.NewBatch().
+		RelationshipIndexRemove(indexName, "name", "first", relationship).
+		Execute()
+	if err != nil {
+		t.Error(err)
+	}
+
+	res, err = neo4jConnection.RelationshipIndexGet(indexName, "name", "first")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res) != 0 {
+		t.Error("Relationship is not removed from index", res)
+	}
+
+	if err := neo4jConnection.DeleteRelationshipIndex(indexName); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIndexEntryValidation(t *testing.T) {
+	entries := []*IndexEntry{
+		{Key: "name", Value: "v", Relationship: &Relationship{ID: "1"}},
+		{Index: "i", Key: "name", Value: "v"},
+		{Index: "i", Key: "name", Value: "v", Relationship: &Relationship{}},
+		{Index: "i", Value: "v", Relationship: &Relationship{ID: "1"}},
+		{Index: "i", Key: "name", Value: "v", Node: &Node{ID: "1"}, Relationship: &Relationship{ID: "1"}},
+	}
+
+	for _, entry := range entries {
+		if _, err := entry.getBatchQuery(BatchCreate); err == nil {
+			t.Error("Invalid entry is accepted", entry)
+		}
+	}
+
+	entry := &IndexEntry{Index: "my index", Key: "name", Value: "a b", Relationship: &Relationship{ID: "7"}}
+	query, err := entry.getBatchQuery(BatchDelete)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query["to"] != "/index/relationship/my%20index/name/a%20b/7" {
+		t.Error("Delete path is not valid", query["to"])
+	}
+
+	entry.Relationship.ID = "{0}"
+	query, err = entry.getBatchQuery(BatchCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query["body"].(map[string]interface{})["uri"] != "{0}" {
+		t.Error("Batch reference is not used", query["body"])
+	}
+}
+
+func TestNodeIndex(t *testing.T) {
+	neo4jConnection := Connect("")
+	indexName := uniqueLabel("nodeIndexTest")
+
+	if err := neo4jConnection.CreateNodeIndex(&Index{Name: indexName}); err != nil {
+		t.Fatal(err)
+	}
+	defer neo4jConnection.DeleteIndex(indexName)
+
+	existing := createNewNode()
+	if err := neo4jConnection.Create(existing); err != nil {
+		t.Fatal(err)
+	}
+
+	created := createNewNode()
+
+	// index a node created in the same batch and an existing one
+	_, err := neo4jConnection.NewBatch().
+		Create(created).
+		IndexAdd(indexName, "name", "Johnny", &Node{ID: "{0}"}).
+		IndexAdd(indexName, "name", "Jonathan", existing).
+		IndexAdd(indexName, "age", 42, existing).
+		Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := neo4jConnection.IndexGet(indexName, "name", "Johnny")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res) != 1 || res[0].ID != created.ID {
+		t.Error("Exact lookup is not valid", res)
+	}
+
+	res, err = neo4jConnection.IndexGet(indexName, "age", 42)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res) != 1 || res[0].ID != existing.ID {
+		t.Error("Numeric lookup is not valid", res)
+	}
+
+	res, err = neo4jConnection.IndexQuery(indexName, "name:Jo*")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res) != 2 {
+		t.Error(len(res), "Query lookup is not valid")
+	}
+
+	// remove all entries of the node
+	if _, err := neo4jConnection.NewBatch().IndexRemove(indexName, "", nil, existing).Execute(); err != nil {
+		t.Error(err)
+	}
+
+	res, err = neo4jConnection.IndexQuery(indexName, "name:Jo*")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res) != 1 {
+		t.Error(len(res), "Node is not removed from index")
+	}
+
+	if _, err := neo4jConnection.IndexGet("", "name", "Johnny"); err == nil {
+		t.Error("Lookup without index name must fail")
+	}
+}
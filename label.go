@@ -0,0 +1,205 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// NodeLabels is used to read and change the labels of a node within a Batch
+//
+// Operations;
+//
+//	BatchGet    reads the labels of the node into Labels
+//	BatchCreate adds Labels to the node
+//	BatchUpdate replaces all labels of the node with Labels
+//	BatchDelete removes the only label in Labels from the node
+//
+// The node can be created in the same batch by using a Reference;
+//
+//	ref := batch.CreateRef(node)
+//	batch.AddLabels(ref.Node(), "Person")
+type NodeLabels struct {
+	Node   *Node
+	Labels []string
+
+	operation string
+}
+
+// AddLabels adds labels to the node as batch
+func (batch *Batch) AddLabels(node *Node, labels ...string) *Batch {
+	batch.addToStack(BatchCreate, &NodeLabels{Node: node, Labels: labels})
+
+	return batch
+}
+
+// ReplaceLabels replaces all labels of the node as batch
+func (batch *Batch) ReplaceLabels(node *Node, labels ...string) *Batch {
+	batch.addToStack(BatchUpdate, &NodeLabels{Node: node, Labels: labels})
+
+	return batch
+}
+
+// RemoveLabel removes a label from the node as batch
+func (batch *Batch) RemoveLabel(node *Node, label string) *Batch {
+	batch.addToStack(BatchDelete, &NodeLabels{Node: node, Labels: []string{label}})
+
+	return batch
+}
+
+// GetLabels reads the labels of the node into node.Labels as batch
+func (batch *Batch) GetLabels(node *Node) *Batch {
+	batch.addToStack(BatchGet, &NodeLabels{Node: node})
+
+	return batch
+}
+
+// GetNodesByLabel queries the nodes with given label, if property is not
+// empty only the nodes having the property with given value are returned
+func (neo4j *Neo4j) GetNodesByLabel(label, property string, value interface{}) ([]Node, error) {
+	return neo4j.GetNodesByLabelContext(context.Background(), label, property, value)
+}
+
+// GetNodesByLabelContext is like GetNodesByLabel but aborts the request when
+// ctx is done
+func (neo4j *Neo4j) GetNodesByLabelContext(ctx context.Context, label, property string, value interface{}) ([]Node, error) {
+	if label == "" {
+		return nil, errors.New("Label is not given")
+	}
+
+	customReq := &ManuelBatchRequest{}
+	customReq.To = fmt.Sprintf("/label/%s/nodes", url.PathEscape(label))
+
+	if property != "" {
+		// property value is sent in json format
+		encodedValue, err := jsonEncode(value)
+		if err != nil {
+			return nil, err
+		}
+
+		params := url.Values{}
+		params.Set(property, encodedValue)
+		customReq.To += "?" + params.Encode()
+	}
+
+	if _, err := neo4j.NewBatch().Get(customReq).ExecuteContext(ctx); err != nil {
+		return nil, err
+	}
+
+	result := []Node{}
+	err := neo4j.GetManualBatchResponse(customReq, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAllLabels queries all labels used in the database
+func (neo4j *Neo4j) GetAllLabels() ([]string, error) {
+	return neo4j.GetAllLabelsContext(context.Background())
+}
+
+// GetAllLabelsContext is like GetAllLabels but aborts the request when ctx
+// is done
+func (neo4j *Neo4j) GetAllLabelsContext(ctx context.Context) ([]string, error) {
+	var result = make([]string, 0)
+	response, err := neo4j.doRequest(ctx, "GET", neo4j.NodeLabelsURL, "")
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Implement Batcher interface
+func (nl *NodeLabels) getBatchQuery(operation string) (map[string]interface{}, error) {
+	query := make(map[string]interface{})
+
+	if nl.Node == nil || nl.Node.ID == "" {
+		return query, errors.New("Node Id not valid")
+	}
+
+	nl.operation = operation
+	to := nodePath(nl.Node.ID) + "/labels"
+
+	switch operation {
+	case BatchGet:
+		query["method"] = "GET"
+	case BatchCreate, BatchUpdate:
+		if len(nl.Labels) == 0 {
+			return query, errors.New("Labels are not given")
+		}
+
+		query["body"] = nl.Labels
+		query["method"] = "POST"
+		if operation == BatchUpdate {
+			query["method"] = "PUT"
+		}
+	case BatchDelete:
+		if len(nl.Labels) != 1 {
+			return query, errors.New("Only one label can be removed at once")
+		}
+
+		query["method"] = "DELETE"
+		to += "/" + url.PathEscape(nl.Labels[0])
+	default:
+		return query, fmt.Errorf("Operation %s is not supported for labels", operation)
+	}
+
+	query["to"] = to
+
+	return query, nil
+}
+
+func (nl *NodeLabels) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error) {
+	switch nl.operation {
+	case BatchGet:
+		items, ok := data.([]interface{})
+		if !ok {
+			return false, errors.New("Response is not an array")
+		}
+
+		labels := make([]string, len(items))
+		for i, item := range items {
+			labels[i], _ = item.(string)
+		}
+		nl.Labels = labels
+		nl.Node.Labels = labels
+	case BatchCreate:
+		for _, label := range nl.Labels {
+			if !hasLabel(nl.Node.Labels, label) {
+				nl.Node.Labels = append(nl.Node.Labels, label)
+			}
+		}
+	case BatchUpdate:
+		nl.Node.Labels = append([]string{}, nl.Labels...)
+	case BatchDelete:
+		labels := make([]string, 0, len(nl.Node.Labels))
+		for _, label := range nl.Node.Labels {
+			if label != nl.Labels[0] {
+				labels = append(labels, label)
+			}
+		}
+		nl.Node.Labels = labels
+	}
+
+	return true, nil
+}
+
+func hasLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,132 @@
+package neo4j
+
+import (
+	"testing"
+)
+
+func TestBatchCreateNodeWithLabels(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	node := createNewNode()
+	node.Data["labelTest"] = "batchCreate"
+	batch.Create(node)
+
+	labelTarget := &Node{ID: "{0}"}
+	batch.AddLabels(labelTarget, "LabelTestPerson", "LabelTestUser")
+
+	res, err := batch.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 2 {
+		t.Error(len(res), "Response length is not valid")
+	}
+
+	if len(labelTarget.Labels) != 2 {
+		t.Error("Labels are not set", labelTarget.Labels)
+	}
+
+	fetched := &Node{ID: node.ID}
+	_, err = neo4jConnection.NewBatch().GetLabels(fetched).Execute()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(fetched.Labels) != 2 {
+		t.Error("Labels are not valid", fetched.Labels)
+	}
+}
+
+func TestReplaceAndRemoveLabels(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	node := createNewNode()
+	if err := neo4jConnection.Create(node); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := neo4jConnection.NewBatch().
+		AddLabels(node, "LabelTestA", "LabelTestB").
+		ReplaceLabels(node, "LabelTestC", "LabelTestD").
+		RemoveLabel(node, "LabelTestC").
+		GetLabels(node).
+		Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(node.Labels) != 1 || node.Labels[0] != "LabelTestD" {
+		t.Error("Labels are not valid", node.Labels)
+	}
+
+	if _, err := neo4jConnection.NewBatch().RemoveLabel(&Node{}, "LabelTestD").Execute(); err == nil {
+		t.Error("Removing label from node without id must fail")
+	}
+}
+
+func TestGetNodesByLabel(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	node := &Node{Data: map[string]interface{}{"name": "byLabel", "age": 33}}
+	if err := neo4jConnection.Create(node); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := neo4jConnection.NewBatch().AddLabels(node, "LabelTestLookup").Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	nodes, err := neo4jConnection.GetNodesByLabel("LabelTestLookup", "", nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(nodes) == 0 {
+		t.Error("Labelled node is not returned")
+	}
+
+	nodes, err = neo4jConnection.GetNodesByLabel("LabelTestLookup", "age", 33)
+	if err != nil {
+		t.Error(err)
+	}
+
+	found := false
+	for _, n := range nodes {
+		if n.ID == node.ID {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Error("Node is not found by its property", nodes)
+	}
+
+	labels, err := neo4jConnection.GetAllLabels()
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !hasLabel(labels, "LabelTestLookup") {
+		t.Error("Label is not returned", labels)
+	}
+}
+
+func TestIsBatchReference(t *testing.T) {
+	for _, id := range []string{"{0}", "{12}"} {
+		if !isBatchReference(id) {
+			t.Error(id, "must be a batch reference")
+		}
+	}
+
+	for _, id := range []string{"", "12", "{}", "{a}", "{1", "1}"} {
+		if isBatchReference(id) {
+			t.Error(id, "must not be a batch reference")
+		}
+	}
+
+	if nodePath("{1}") != "{1}" || nodePath("12") != "/node/12" {
+		t.Error("node path is not valid")
+	}
+}
@@ -0,0 +1,524 @@
+package neo4j
+
+// this file maps structs to node and relationship properties and back
+//
+// Fields are mapped with the "neo4j" struct tag;
+//
+//	type Person struct {
+//		Name    string    `neo4j:"name"`
+//		Age     int       `neo4j:"age,omitempty"`
+//		Born    time.Time `neo4j:"born"`
+//		Tags    []string  `neo4j:"tags"`
+//		Address Address   `neo4j:"address"`
+//		Secret  string    `neo4j:"-"`
+//	}
+//
+// Fields without tag use the field name, fields tagged with "-" and
+// unexported fields are skipped. omitempty skips zero values.
+//
+// Neo4j only stores primitive values and arrays of them, so;
+//   - nested structs and maps are flattened with dotted keys, eg: "address.city"
+//   - embedded structs are flattened without a prefix
+//   - time.Time is stored as RFC 3339 string
+//   - nil pointers are not stored
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// propertiesFrom creates properties from the given struct or pointer to struct
+func propertiesFrom(src interface{}) (map[string]interface{}, error) {
+	v := reflect.ValueOf(src)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, errors.New("Source is nil")
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("Source must be a struct, got %s", v.Type())
+	}
+
+	properties := make(map[string]interface{})
+	if err := encodeStruct(v, "", properties); err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+// scanProperties copies the properties into the struct dst points to
+func scanProperties(properties map[string]interface{}, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("Destination must be a non nil pointer")
+	}
+
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("Destination must point to a struct, got %s", v.Type())
+	}
+
+	return decodeStruct(v, "", properties)
+}
+
+// parseTag returns the property name of the field and its options
+func parseTag(field reflect.StructField) (name string, omitEmpty, skip bool) {
+	tag := field.Tag.Get("neo4j")
+	if tag == "-" {
+		return "", false, true
+	}
+
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+
+	return name, omitEmpty, false
+}
+
+// isEmbeddedStruct checks if the field is an untagged embedded struct
+func isEmbeddedStruct(field reflect.StructField) bool {
+	if !field.Anonymous || field.Tag.Get("neo4j") != "" {
+		return false
+	}
+
+	t := field.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+func encodeStruct(v reflect.Value, prefix string, properties map[string]interface{}) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty, skip := parseTag(field)
+		if skip {
+			continue
+		}
+
+		fv := v.Field(i)
+
+		if isEmbeddedStruct(field) {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+
+			if err := encodeStruct(fv, prefix, properties); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// unexported
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+
+		if err := encodeValue(fv, prefix+name, properties); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeValue(v reflect.Value, key string, properties map[string]interface{}) error {
+	// null is not a valid property value
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		properties[key] = v.Interface().(time.Time).Format(time.RFC3339Nano)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return encodeStruct(v, key+".", properties)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("Map key of %s must be string", key)
+		}
+
+		for _, k := range v.MapKeys() {
+			if err := encodeValue(v.MapIndex(k), key+"."+k.String(), properties); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			item, err := encodeArrayItem(v.Index(i))
+			if err != nil {
+				return fmt.Errorf("%s[%d]: %s", key, i, err)
+			}
+			items[i] = item
+		}
+		properties[key] = items
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		properties[key] = v.Interface()
+	default:
+		return fmt.Errorf("Type %s of %s is not supported", v.Type(), key)
+	}
+
+	return nil
+}
+
+// arrays can only hold primitive values
+func encodeArrayItem(v reflect.Value) (interface{}, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, errors.New("Arrays can not contain nil")
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339Nano), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return v.Interface(), nil
+	}
+
+	return nil, fmt.Errorf("Arrays can not contain %s", v.Type())
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).IsZero()
+	}
+
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+func decodeStruct(v reflect.Value, prefix string, properties map[string]interface{}) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, skip := parseTag(field)
+		if skip {
+			continue
+		}
+
+		fv := v.Field(i)
+
+		if isEmbeddedStruct(field) {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					if !fv.CanSet() {
+						continue
+					}
+					fv.Set(reflect.New(fv.Type().Elem()))
+				}
+				fv = fv.Elem()
+			}
+
+			if err := decodeStruct(fv, prefix, properties); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// unexported
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if err := decodeValue(fv, prefix+name, properties); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeValue(v reflect.Value, key string, properties map[string]interface{}) error {
+	base := v.Type()
+	for base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+
+	// nested values are read from the flattened keys
+	if (base.Kind() == reflect.Struct && base != timeType) || base.Kind() == reflect.Map {
+		prefix := key + "."
+		if !hasPrefixedKey(properties, prefix) {
+			return nil
+		}
+
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+
+		if v.Kind() == reflect.Struct {
+			return decodeStruct(v, prefix, properties)
+		}
+
+		return decodeMap(v, prefix, properties)
+	}
+
+	value, ok := properties[key]
+	if !ok || value == nil {
+		return nil
+	}
+
+	if err := setValue(v, value); err != nil {
+		return fmt.Errorf("Cannot scan %s: %s", key, err)
+	}
+
+	return nil
+}
+
+func decodeMap(v reflect.Value, prefix string, properties map[string]interface{}) error {
+	if v.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("Map key of %s must be string", strings.TrimSuffix(prefix, "."))
+	}
+
+	if v.IsNil() {
+		v.Set(reflect.MakeMap(v.Type()))
+	}
+
+	for key, value := range properties {
+		if !strings.HasPrefix(key, prefix) || value == nil {
+			continue
+		}
+
+		item := reflect.New(v.Type().Elem()).Elem()
+		if err := setValue(item, value); err != nil {
+			return fmt.Errorf("Cannot scan %s: %s", key, err)
+		}
+
+		mapKey := reflect.ValueOf(strings.TrimPrefix(key, prefix)).Convert(v.Type().Key())
+		v.SetMapIndex(mapKey, item)
+	}
+
+	return nil
+}
+
+func hasPrefixedKey(properties map[string]interface{}, prefix string) bool {
+	for key := range properties {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setValue sets v to the given property value, numbers are converted to
+// the type of v if they fit. A nil value, eg: an array element, leaves the
+// zero value like the missing properties
+func setValue(v reflect.Value, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setValue(v.Elem(), value)
+	}
+
+	if v.Type() == timeType {
+		return setTime(v, value)
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if !rv.Type().AssignableTo(v.Type()) {
+			return fmt.Errorf("%s is not assignable to %s", rv.Type(), v.Type())
+		}
+		v.Set(rv)
+	case reflect.Bool:
+		if rv.Kind() != reflect.Bool {
+			return fmt.Errorf("%s is not a bool", rv.Type())
+		}
+		v.SetBool(rv.Bool())
+	case reflect.String:
+		if rv.Kind() != reflect.String {
+			return fmt.Errorf("%s is not a string", rv.Type())
+		}
+		v.SetString(rv.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := toInt(value)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(n) {
+			return fmt.Errorf("%v does not fit into %s", value, v.Type())
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := toInt(value)
+		if err != nil {
+			return err
+		}
+		if n < 0 || v.OverflowUint(uint64(n)) {
+			return fmt.Errorf("%v does not fit into %s", value, v.Type())
+		}
+		v.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat(value)
+		if err != nil {
+			return err
+		}
+		if v.OverflowFloat(f) {
+			return fmt.Errorf("%v does not fit into %s", value, v.Type())
+		}
+		v.SetFloat(f)
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return fmt.Errorf("%s is not an array", rv.Type())
+		}
+
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), rv.Len(), rv.Len()))
+		} else if rv.Len() > v.Len() {
+			return fmt.Errorf("%d items do not fit into %s", rv.Len(), v.Type())
+		}
+
+		for i := 0; i < rv.Len(); i++ {
+			if err := setValue(v.Index(i), rv.Index(i).Interface()); err != nil {
+				return fmt.Errorf("[%d]: %s", i, err)
+			}
+		}
+	default:
+		return fmt.Errorf("Type %s is not supported", v.Type())
+	}
+
+	return nil
+}
+
+// setTime accepts RFC 3339 strings and unix timestamps in seconds
+func setTime(v reflect.Value, value interface{}) error {
+	if s, ok := value.(string); ok {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	f, err := toFloat(value)
+	if err != nil {
+		return err
+	}
+
+	sec, frac := math.Modf(f)
+	v.Set(reflect.ValueOf(time.Unix(int64(sec), int64(frac*1e9))))
+
+	return nil
+}
+
+// json decodes all numbers as float64 but properties set by hand may hold
+// any kind of number
+func toFloat(value interface{}) (float64, error) {
+	if n, ok := value.(json.Number); ok {
+		return n.Float64()
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	}
+
+	return 0, fmt.Errorf("%s is not a number", rv.Type())
+}
+
+// toInt converts whole numbers to int64
+func toInt(value interface{}) (int64, error) {
+	if n, ok := value.(json.Number); ok {
+		return n.Int64()
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("%v does not fit into int64", value)
+		}
+		return int64(rv.Uint()), nil
+	}
+
+	f, err := toFloat(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, fmt.Errorf("%v is not a whole number", value)
+	}
+
+	return int64(f), nil
+}
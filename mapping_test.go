@@ -0,0 +1,234 @@
+package neo4j
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type mappingAddress struct {
+	City    string `neo4j:"city"`
+	ZipCode int    `neo4j:"zip,omitempty"`
+}
+
+type mappingBase struct {
+	CreatedAt time.Time `neo4j:"createdAt"`
+}
+
+type mappingPerson struct {
+	mappingBase
+	Name     string            `neo4j:"name"`
+	Age      int               `neo4j:"age,omitempty"`
+	Score    float32           `neo4j:"score"`
+	Active   bool              `neo4j:"active"`
+	Tags     []string          `neo4j:"tags"`
+	Counts   []uint8           `neo4j:"counts"`
+	Nickname *string           `neo4j:"nickname"`
+	Address  mappingAddress    `neo4j:"address"`
+	Extra    map[string]string `neo4j:"extra"`
+	Untagged string
+	Secret   string `neo4j:"-"`
+	private  string
+}
+
+// roundTrip imitates sending properties to Neo4j and reading them back
+func roundTrip(t *testing.T, properties map[string]interface{}) map[string]interface{} {
+	encoded, err := json.Marshal(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	return decoded
+}
+
+func TestNewNodeFromStruct(t *testing.T) {
+	nickname := "jd"
+	created := time.Date(2015, 10, 13, 11, 21, 43, 0, time.UTC)
+	person := &mappingPerson{
+		mappingBase: mappingBase{CreatedAt: created},
+		Name:        "John Doe",
+		Score:       1.5,
+		Active:      true,
+		Tags:        []string{"a", "b"},
+		Counts:      []uint8{1, 2},
+		Nickname:    &nickname,
+		Address:     mappingAddress{City: "Istanbul"},
+		Extra:       map[string]string{"key": "value"},
+		Untagged:    "untagged",
+		Secret:      "secret",
+		private:     "private",
+	}
+
+	node, err := NewNodeFrom(person)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"createdAt":    "2015-10-13T11:21:43Z",
+		"name":         "John Doe",
+		"score":        float32(1.5),
+		"active":       true,
+		"nickname":     "jd",
+		"address.city": "Istanbul",
+		"extra.key":    "value",
+		"Untagged":     "untagged",
+	}
+
+	for key, value := range expected {
+		if node.Data[key] != value {
+			t.Errorf("%s is not valid, got %v", key, node.Data[key])
+		}
+	}
+
+	for _, key := range []string{"age", "address.zip", "Secret", "private", "address"} {
+		if _, ok := node.Data[key]; ok {
+			t.Errorf("%s must not be set", key)
+		}
+	}
+
+	if tags, ok := node.Data["tags"].([]interface{}); !ok || len(tags) != 2 {
+		t.Error("tags are not valid", node.Data["tags"])
+	}
+}
+
+func TestNodeScanIntoStruct(t *testing.T) {
+	nickname := "jd"
+	person := &mappingPerson{
+		mappingBase: mappingBase{CreatedAt: time.Date(2015, 10, 13, 11, 21, 43, 5, time.UTC)},
+		Name:        "John Doe",
+		Age:         42,
+		Score:       1.5,
+		Active:      true,
+		Tags:        []string{"a", "b"},
+		Counts:      []uint8{1, 2},
+		Nickname:    &nickname,
+		Address:     mappingAddress{City: "Istanbul", ZipCode: 34000},
+		Extra:       map[string]string{"key": "value"},
+		Untagged:    "untagged",
+	}
+
+	node, err := NewNodeFrom(person)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node.Data = roundTrip(t, node.Data)
+
+	scanned := &mappingPerson{}
+	if err := node.Scan(scanned); err != nil {
+		t.Fatal(err)
+	}
+
+	if !scanned.CreatedAt.Equal(person.CreatedAt) {
+		t.Error("time is not valid", scanned.CreatedAt)
+	}
+
+	if scanned.Name != person.Name || scanned.Age != 42 || scanned.Score != 1.5 || !scanned.Active {
+		t.Error("primitive values are not valid", scanned)
+	}
+
+	if len(scanned.Tags) != 2 || scanned.Tags[1] != "b" || len(scanned.Counts) != 2 || scanned.Counts[1] != 2 {
+		t.Error("arrays are not valid", scanned.Tags, scanned.Counts)
+	}
+
+	if scanned.Nickname == nil || *scanned.Nickname != "jd" {
+		t.Error("pointer is not valid", scanned.Nickname)
+	}
+
+	if scanned.Address.City != "Istanbul" || scanned.Address.ZipCode != 34000 {
+		t.Error("nested struct is not valid", scanned.Address)
+	}
+
+	if scanned.Extra["key"] != "value" {
+		t.Error("map is not valid", scanned.Extra)
+	}
+
+	if scanned.Untagged != "untagged" {
+		t.Error("untagged field is not valid", scanned.Untagged)
+	}
+}
+
+func TestScanArrayWithNilElement(t *testing.T) {
+	var dst struct {
+		Tags   []string      `neo4j:"tags"`
+		Values []interface{} `neo4j:"values"`
+	}
+
+	node := &Node{Data: map[string]interface{}{
+		"tags":   []interface{}{"a", nil},
+		"values": []interface{}{nil, "b"},
+	}}
+
+	if err := node.Scan(&dst); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dst.Tags) != 2 || dst.Tags[0] != "a" || dst.Tags[1] != "" {
+		t.Error("nil string element is not valid", dst.Tags)
+	}
+
+	if len(dst.Values) != 2 || dst.Values[0] != nil || dst.Values[1] != "b" {
+		t.Error("nil interface element is not valid", dst.Values)
+	}
+}
+
+func TestScanRejectsInvalidValues(t *testing.T) {
+	var dst struct {
+		Age   int8   `neo4j:"age"`
+		Count uint   `neo4j:"count"`
+		Name  string `neo4j:"name"`
+	}
+
+	invalid := []map[string]interface{}{
+		{"age": 1.5},
+		{"age": float64(300)},
+		{"count": float64(-1)},
+		{"name": float64(1)},
+	}
+
+	for _, data := range invalid {
+		node := &Node{Data: data}
+		if err := node.Scan(&dst); err == nil {
+			t.Error("scanning must fail for", data)
+		}
+	}
+
+	node := &Node{Data: map[string]interface{}{"age": 1}}
+	if err := node.Scan(dst); err == nil {
+		t.Error("scanning into non pointer must fail")
+	}
+}
+
+func TestRelationshipScanAndNewRelationshipFrom(t *testing.T) {
+	type knows struct {
+		Since int       `neo4j:"since"`
+		At    time.Time `neo4j:"at"`
+	}
+
+	rel, err := NewRelationshipFrom(knows{Since: 2015})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rel.Data["since"] != 2015 {
+		t.Error("relationship data is not valid", rel.Data)
+	}
+
+	rel.Data = roundTrip(t, rel.Data)
+	rel.Data["at"] = float64(1444735303)
+
+	scanned := knows{}
+	if err := rel.Scan(&scanned); err != nil {
+		t.Fatal(err)
+	}
+
+	if scanned.Since != 2015 || scanned.At.Unix() != 1444735303 {
+		t.Error("relationship scan is not valid", scanned)
+	}
+}
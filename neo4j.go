@@ -1,20 +1,51 @@
 package neo4j
 
 import (
+	"context"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
 // Neo4j base struct
 type Neo4j struct {
-	Client            *http.Client
-	BaseURL           string
-	NodeURL           string
-	BatchURL          string
-	RelationshipURL   string
-	IndexNodeURL      string
-	BasicAuthUser     string
-	BasicAuthPassword string
+	Client               *http.Client
+	BaseURL              string
+	NodeURL              string
+	BatchURL             string
+	RelationshipURL      string
+	RelationshipTypesURL string
+	IndexNodeURL         string
+	IndexRelationshipURL string
+	NodeLabelsURL        string
+	TransactionURL       string
+	CypherURL            string
+	SchemaIndexURL       string
+	ConstraintURL        string
+	ExtensionsInfoURL    string
+	BasicAuthUser        string
+	BasicAuthPassword    string
+	UserAgent            string
+
+	// Auth authenticates the requests, BasicAuthUser and BasicAuthPassword
+	// are used if it is nil
+	Auth Authenticator
+
+	// Version and Extensions are set by Discover, see ServiceRoot
+	Version    string
+	Extensions map[string]map[string]string
+
+	// RetryPolicy configures retrying the failed requests, they are not
+	// retried if it is nil
+	RetryPolicy *RetryPolicy
+
+	// MaxBatchSize limits the number of operations sent in one batch
+	// request, bigger batches are executed in chunks, see
+	// Batch.ExecuteChunked. There is no limit if it is zero
+	MaxBatchSize int
+
+	// authMu guards the basic auth credentials against ChangePassword
+	authMu sync.RWMutex
 }
 
 // Connect creates the basic structure to send requests to neo4j rest endpoint.
@@ -38,7 +69,9 @@ type Neo4j struct {
 //
 //     http://127.0.0.1:7474
 //
-// TODO implement cluster connection
+// ConnectWithOptions can be used to configure the http client and to
+// discover the urls from the service root, ConnectCluster to connect to
+// the members of an HA cluster
 func Connect(urlString string) *Neo4j {
 	if urlString == "" {
 		urlString = "http://127.0.0.1:7474"
@@ -71,17 +104,25 @@ func Connect(urlString string) *Neo4j {
 
 	}
 
-	// TODO get neo4j service root from neo4j itself
-	// http://docs.neo4j.org/chunked/stable/rest-api-service-root.html
+	// urls are set to their defaults, Discover can be used to get them
+	// from the service root
 	return &Neo4j{
-		Client:            http.DefaultClient,
-		BaseURL:           baseURL,
-		NodeURL:           baseURL + "/node",
-		BatchURL:          baseURL + "/batch",
-		IndexNodeURL:      baseURL + "/index/node",
-		RelationshipURL:   baseURL + "/relationship",
-		BasicAuthUser:     username,
-		BasicAuthPassword: password,
+		Client:               http.DefaultClient,
+		BaseURL:              baseURL,
+		NodeURL:              baseURL + "/node",
+		BatchURL:             baseURL + "/batch",
+		IndexNodeURL:         baseURL + "/index/node",
+		IndexRelationshipURL: baseURL + "/index/relationship",
+		NodeLabelsURL:        baseURL + "/labels",
+		RelationshipURL:      baseURL + "/relationship",
+		RelationshipTypesURL: baseURL + "/relationship/types",
+		TransactionURL:       baseURL + "/transaction",
+		CypherURL:            baseURL + "/cypher",
+		SchemaIndexURL:       baseURL + "/schema/index",
+		ConstraintURL:        baseURL + "/schema/constraint",
+		ExtensionsInfoURL:    baseURL + "/ext",
+		BasicAuthUser:        username,
+		BasicAuthPassword:    password,
 	}
 }
 
@@ -104,7 +145,12 @@ func Connect(urlString string) *Neo4j {
 //    rel.Id          = "2229"
 //    neo4jConnection.Get(rel)
 func (neo4j *Neo4j) Get(obj Batcher) error {
-	_, err := neo4j.NewBatch().Get(obj).Execute()
+	return neo4j.GetContext(context.Background(), obj)
+}
+
+// GetContext is like Get but aborts the request when ctx is done
+func (neo4j *Neo4j) GetContext(ctx context.Context, obj Batcher) error {
+	_, err := neo4j.NewBatch().Get(obj).ExecuteContext(ctx)
 
 	return err
 }
@@ -125,7 +171,12 @@ func (neo4j *Neo4j) Get(obj Batcher) error {
 //
 //    neo4jConnection.Get(rel)
 func (neo4j *Neo4j) Create(obj Batcher) error {
-	_, err := neo4j.NewBatch().Create(obj).Execute()
+	return neo4j.CreateContext(context.Background(), obj)
+}
+
+// CreateContext is like Create but aborts the request when ctx is done
+func (neo4j *Neo4j) CreateContext(ctx context.Context, obj Batcher) error {
+	_, err := neo4j.NewBatch().Create(obj).ExecuteContext(ctx)
 
 	return err
 }
@@ -133,7 +184,12 @@ func (neo4j *Neo4j) Create(obj Batcher) error {
 // Delete is the basic Delete method for all types
 // It accepts only Batcher Interface
 func (neo4j *Neo4j) Delete(obj Batcher) error {
-	_, err := neo4j.NewBatch().Delete(obj).Execute()
+	return neo4j.DeleteContext(context.Background(), obj)
+}
+
+// DeleteContext is like Delete but aborts the request when ctx is done
+func (neo4j *Neo4j) DeleteContext(ctx context.Context, obj Batcher) error {
+	_, err := neo4j.NewBatch().Delete(obj).ExecuteContext(ctx)
 
 	return err
 }
@@ -141,7 +197,12 @@ func (neo4j *Neo4j) Delete(obj Batcher) error {
 // Update is the basic Update method for all types
 // It accepts only Batcher Interface
 func (neo4j *Neo4j) Update(obj Batcher) error {
-	_, err := neo4j.NewBatch().Update(obj).Execute()
+	return neo4j.UpdateContext(context.Background(), obj)
+}
+
+// UpdateContext is like Update but aborts the request when ctx is done
+func (neo4j *Neo4j) UpdateContext(ctx context.Context, obj Batcher) error {
+	_, err := neo4j.NewBatch().Update(obj).ExecuteContext(ctx)
 
 	return err
 }
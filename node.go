@@ -3,13 +3,15 @@ package neo4j
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 )
 
 // Node struct
 type Node struct {
-	ID      string
-	Data    map[string]interface{}
+	ID   string
+	Data map[string]interface{}
+	// Labels are set when the node is read from a server which returns
+	// node metadata, and kept up to date by label batch operations
+	Labels  []string
 	Payload *NodeResponse
 }
 
@@ -27,7 +29,46 @@ type NodeResponse struct {
 	IncomingRelationships      string                 `json:"incoming_relationships"`
 	IncomingTypedRelationships string                 `json:"incoming_typed_relationships"`
 	CreateRelationship         string                 `json:"create_relationship"`
+	Labels                     string                 `json:"labels"`
 	Data                       map[string]interface{} `json:"data"`
+	Metadata                   *NodeMetadata          `json:"metadata"`
+}
+
+// NodeMetadata struct is returned by Neo4j 2.1 and later
+type NodeMetadata struct {
+	ID     int      `json:"id"`
+	Labels []string `json:"labels"`
+}
+
+// NewNodeFrom creates a node with the properties of the given struct, see
+// Scan for the property mapping rules
+func NewNodeFrom(src interface{}) (*Node, error) {
+	data, err := propertiesFrom(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Node{Data: data}, nil
+}
+
+// Scan copies node properties into the struct dst points to.
+// Struct fields are matched with the `neo4j:"name,omitempty"` tag, or
+// the field name if the tag is missing. Numbers are converted to the field
+// type, time.Time fields are read from RFC 3339 strings and nested structs
+// are read from flattened "parent.child" properties.
+//
+// Example usage;
+//
+//	type Person struct {
+//		Name string    `neo4j:"name"`
+//		Age  int       `neo4j:"age,omitempty"`
+//		Born time.Time `neo4j:"born"`
+//	}
+//
+//	person := &Person{}
+//	err := node.Scan(person)
+func (node *Node) Scan(dst interface{}) error {
+	return scanProperties(node.Data, dst)
 }
 
 func (node *Node) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error) {
@@ -44,6 +85,10 @@ func (node *Node) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error)
 	node.Data = payload.Data
 	node.Payload = payload
 
+	if payload.Metadata != nil && payload.Metadata.Labels != nil {
+		node.Labels = payload.Metadata.Labels
+	}
+
 	return true, nil
 }
 
@@ -77,7 +122,7 @@ func prepareNodeGetBatchMap(n *Node) (map[string]interface{}, error) {
 	}
 
 	query["method"] = "GET"
-	query["to"] = fmt.Sprintf("/node/%s", n.ID)
+	query["to"] = nodePath(n.ID)
 
 	return query, nil
 }
@@ -90,7 +135,7 @@ func prepareNodeDeleteBatchMap(n *Node) (map[string]interface{}, error) {
 	}
 
 	query["method"] = "DELETE"
-	query["to"] = fmt.Sprintf("/node/%s", n.ID)
+	query["to"] = nodePath(n.ID)
 
 	return query, nil
 }
@@ -111,7 +156,7 @@ func prepareNodeUpdateBatchMap(n *Node) (map[string]interface{}, error) {
 	}
 
 	query["method"] = "PUT"
-	query["to"] = fmt.Sprintf("/node/%s/properties", n.ID)
+	query["to"] = nodePath(n.ID) + "/properties"
 	query["body"] = n.Data
 
 	return query, nil
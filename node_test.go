@@ -1,9 +1,12 @@
 package neo4j
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 )
 
 func TestDefaultConnection(t *testing.T) {
@@ -28,6 +31,14 @@ func TestDefaultConnection(t *testing.T) {
 	if neo4jConnection.IndexNodeURL == "" {
 		t.Error("IndexNodeUrl is not set")
 	}
+
+	if neo4jConnection.IndexRelationshipURL == "" {
+		t.Error("IndexRelationshipUrl is not set")
+	}
+
+	if neo4jConnection.TransactionURL == "" {
+		t.Error("TransactionUrl is not set")
+	}
 }
 
 func TestGetNodeWithEmptyID(t *testing.T) {
@@ -62,10 +73,27 @@ func TestGetNodeReturnsErrorObjectOnError(t *testing.T) {
 		t.Error("There must be error")
 	}
 
-	tt := reflect.TypeOf(err).String()
-	// find a better way to check this
-	if tt != "*errors.errorString" {
-		t.Error("Error is not valid!")
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		t.Error("Error is not valid!", reflect.TypeOf(err))
+	}
+}
+
+func TestGetDeletedNodeReturnsNotFound(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	node := &Node{}
+	if err := neo4jConnection.Create(node); err != nil {
+		t.Error("Error while creating node", err)
+	}
+
+	if err := neo4jConnection.Delete(node); err != nil {
+		t.Error("Error while deleting node", err)
+	}
+
+	err := neo4jConnection.Get(node)
+	if !IsNotFound(err) {
+		t.Error("Error is not a not found error", err)
 	}
 }
 
@@ -381,3 +409,57 @@ func TestBatchApiWithRelationship(t *testing.T) {
 		t.Error("Deleted relationship returned result", err)
 	}
 }
+
+func TestGetNodeWithCancelledContext(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	node := &Node{}
+	if err := neo4jConnection.Create(node); err != nil {
+		t.Error("Error while creating node", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := neo4jConnection.GetContext(ctx, node)
+	if err == nil {
+		t.Error("Getting node with cancelled context succeeded")
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Error("Error is not context.Canceled", err)
+	}
+}
+
+func TestCreateNodeFromStructAndScan(t *testing.T) {
+	type person struct {
+		Name string    `neo4j:"name"`
+		Age  int       `neo4j:"age"`
+		Born time.Time `neo4j:"born"`
+	}
+
+	src := person{Name: "scanned", Age: 30, Born: time.Date(1985, 1, 2, 0, 0, 0, 0, time.UTC)}
+	node, err := NewNodeFrom(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	neo4jConnection := Connect("")
+	if err := neo4jConnection.Create(node); err != nil {
+		t.Fatal(err)
+	}
+
+	fetched := &Node{ID: node.ID}
+	if err := neo4jConnection.Get(fetched); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := person{}
+	if err := fetched.Scan(&dst); err != nil {
+		t.Error(err)
+	}
+
+	if dst.Name != src.Name || dst.Age != src.Age || !dst.Born.Equal(src.Born) {
+		t.Error("Scanned node is not valid", dst)
+	}
+}
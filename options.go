@@ -0,0 +1,276 @@
+package neo4j
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures the connection created by ConnectWithOptions
+type Option func(*options) error
+
+// options holds the settings given to ConnectWithOptions, the http client
+// is built from them after all options are applied
+type options struct {
+	client       *http.Client
+	timeout      time.Duration
+	tlsConfig    *tls.Config
+	maxIdleConns int
+	proxy        *url.URL
+	userAgent    string
+	maxBatchSize int
+	retryPolicy  *RetryPolicy
+	discover     bool
+	auth         Authenticator
+	user         string
+	password     string
+}
+
+// ConnectWithOptions is like Connect but configures the http client used by
+// all requests with the given options. https:// urls are supported.
+//
+// Example usage;
+//
+//	neo4jConnection, err := ConnectWithOptions("https://db.example.com:7473",
+//		WithTimeout(10*time.Second),
+//		WithCACertFile("/etc/neo4j/ca.pem"),
+//		WithMaxIdleConns(20),
+//		WithUserAgent("importer/1.0"),
+//	)
+func ConnectWithOptions(urlString string, opts ...Option) (*Neo4j, error) {
+	o := &options{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	neo4j := Connect(urlString)
+
+	client, err := o.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	neo4j.Client = client
+
+	neo4j.UserAgent = o.userAgent
+	neo4j.MaxBatchSize = o.maxBatchSize
+	neo4j.RetryPolicy = o.retryPolicy
+
+	if o.user != "" {
+		neo4j.BasicAuthUser = o.user
+		neo4j.BasicAuthPassword = o.password
+	}
+	neo4j.Auth = o.auth
+
+	if o.discover {
+		if err := neo4j.Discover(); err != nil {
+			return nil, err
+		}
+	}
+
+	return neo4j, nil
+}
+
+// WithHTTPClient sets the http client used by the connection, the other
+// transport options can not be used along with it
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) error {
+		if client == nil {
+			return errors.New("Client is not given")
+		}
+
+		o.client = client
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit of the requests, it includes reading the
+// response body
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) error {
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithTLSConfig sets the tls configuration of https connections
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) error {
+		o.tlsConfig = config.Clone()
+		return nil
+	}
+}
+
+// WithCACertFile adds the PEM encoded certificates in the file to the
+// certificate authorities used to verify the server
+func WithCACertFile(file string) Option {
+	return func(o *options) error {
+		pem, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		config := o.tls()
+		if config.RootCAs == nil {
+			config.RootCAs = x509.NewCertPool()
+		}
+
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return errors.New("No certificate found in " + file)
+		}
+
+		return nil
+	}
+}
+
+// WithClientCertFile sets the certificate sent to the server for client
+// authentication, files must contain PEM encoded data
+func WithClientCertFile(certFile, keyFile string) Option {
+	return func(o *options) error {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+
+		config := o.tls()
+		config.Certificates = append(config.Certificates, cert)
+
+		return nil
+	}
+}
+
+// WithMaxIdleConns sets the number of idle connections kept open to the
+// server
+func WithMaxIdleConns(n int) Option {
+	return func(o *options) error {
+		if n < 0 {
+			return errors.New("Max idle connections can not be negative")
+		}
+
+		o.maxIdleConns = n
+		return nil
+	}
+}
+
+// WithProxy sends the requests through the proxy at given url
+func WithProxy(proxyURL string) Option {
+	return func(o *options) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return err
+		}
+
+		o.proxy = u
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithBasicAuth sets the credentials of the requests, they override the
+// ones given in the url
+func WithBasicAuth(user, password string) Option {
+	return func(o *options) error {
+		o.user = user
+		o.password = password
+		return nil
+	}
+}
+
+// WithAuthenticator sets Neo4j.Auth, eg: a BearerAuth
+func WithAuthenticator(auth Authenticator) Option {
+	return func(o *options) error {
+		o.auth = auth
+		return nil
+	}
+}
+
+// WithMaxBatchSize sets Neo4j.MaxBatchSize
+func WithMaxBatchSize(size int) Option {
+	return func(o *options) error {
+		if size < 0 {
+			return errors.New("Max batch size can not be negative")
+		}
+
+		o.maxBatchSize = size
+		return nil
+	}
+}
+
+// WithRetryPolicy sets Neo4j.RetryPolicy
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) error {
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithDiscovery gets the urls of the endpoints from the service root while
+// connecting, see Neo4j.Discover
+func WithDiscovery() Option {
+	return func(o *options) error {
+		o.discover = true
+		return nil
+	}
+}
+
+// tls returns the tls configuration, it is created if not set yet
+func (o *options) tls() *tls.Config {
+	if o.tlsConfig == nil {
+		o.tlsConfig = &tls.Config{}
+	}
+
+	return o.tlsConfig
+}
+
+// httpClient builds the http client from the options
+func (o *options) httpClient() (*http.Client, error) {
+	transportSet := o.tlsConfig != nil || o.maxIdleConns > 0 || o.proxy != nil
+
+	if o.client != nil {
+		if transportSet || o.timeout > 0 {
+			return nil, errors.New("Transport options can not be used with a custom http client")
+		}
+
+		return o.client, nil
+	}
+
+	if !transportSet && o.timeout == 0 {
+		return http.DefaultClient, nil
+	}
+
+	client := &http.Client{Timeout: o.timeout}
+	if !transportSet {
+		return client, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if o.tlsConfig != nil {
+		transport.TLSClientConfig = o.tlsConfig
+	}
+
+	if o.maxIdleConns > 0 {
+		// all connections are made to the same server
+		transport.MaxIdleConns = o.maxIdleConns
+		transport.MaxIdleConnsPerHost = o.maxIdleConns
+	}
+
+	if o.proxy != nil {
+		transport.Proxy = http.ProxyURL(o.proxy)
+	}
+
+	client.Transport = transport
+
+	return client, nil
+}
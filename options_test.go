@@ -0,0 +1,148 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestConnectWithOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "neo4j-test" {
+			t.Error("User agent is not set", r.Header.Get("User-Agent"))
+		}
+
+		user, password, _ := r.BasicAuth()
+		if user != "neo4j" || password != "secret" {
+			t.Error("Credentials are not set", user, password)
+		}
+
+		w.Write([]byte(`["Person"]`))
+	}))
+	defer server.Close()
+
+	caFile, err := ioutil.TempFile("", "neo4j-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(caFile.Name())
+
+	pem.Encode(caFile, &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	caFile.Close()
+
+	neo4jConnection, err := ConnectWithOptions(server.URL,
+		WithTimeout(5*time.Second),
+		WithCACertFile(caFile.Name()),
+		WithMaxIdleConns(4),
+		WithUserAgent("neo4j-test"),
+		WithBasicAuth("neo4j", "secret"),
+		WithMaxBatchSize(100),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if neo4jConnection.MaxBatchSize != 100 {
+		t.Error("Max batch size is not set")
+	}
+
+	labels, err := neo4jConnection.GetAllLabels()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 1 {
+		t.Error("Labels are not valid", labels)
+	}
+
+	// manual requests use the same client
+	req := neo4jConnection.NewManuelRequest(server.URL + "/db/data/labels")
+	if _, err := req.Get(); err != nil {
+		t.Error(err)
+	}
+
+	// server certificate can not be verified without the ca
+	if _, err := Connect(server.URL).GetAllLabels(); err == nil {
+		t.Error("Default client should not trust the test server")
+	}
+}
+
+func TestConnectWithInvalidOptions(t *testing.T) {
+	if _, err := ConnectWithOptions("", WithHTTPClient(&http.Client{}), WithTimeout(time.Second)); err == nil {
+		t.Error("Custom client should not be used with transport options")
+	}
+
+	if _, err := ConnectWithOptions("", WithCACertFile("/does/not/exist")); err == nil {
+		t.Error("Missing ca file should return an error")
+	}
+
+	neo4jConnection, err := ConnectWithOptions("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if neo4jConnection.Client != http.DefaultClient {
+		t.Error("Default client should be used without options")
+	}
+}
+
+func TestManuelRequestContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "neo4j-test" {
+			t.Error("Connection options are not used", r.Header.Get("User-Agent"))
+		}
+
+		if r.Method == "GET" {
+			w.Write([]byte(`["a"]`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	neo4jConnection, err := ConnectWithOptions(server.URL, WithUserAgent("neo4j-test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := neo4jConnection.NewManuelRequest(server.URL + "/db/data/labels")
+	if res, err := req.GetContext(context.Background()); err != nil || len(res) != 1 {
+		t.Error("Response is not valid", res, err)
+	}
+
+	if err := req.DeleteContext(context.Background()); err != nil {
+		t.Error(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := req.GetContext(ctx); err == nil {
+		t.Error("Canceled get should fail")
+	}
+
+	if err := req.PostContext(ctx); err == nil {
+		t.Error("Canceled post should fail")
+	}
+}
+
+func TestManuelRequestServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"message":"Unknown error","exception":"RuntimeException"}`))
+	}))
+	defer server.Close()
+
+	req := Connect(server.URL).NewManuelRequest(server.URL + "/db/data/labels")
+
+	var nerr *Neo4jError
+	if _, err := req.Get(); !errors.As(err, &nerr) || nerr.StatusCode != http.StatusInternalServerError {
+		t.Error("Server error is not returned", err)
+	}
+}
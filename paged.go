@@ -0,0 +1,262 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrTraverserExpired is returned by PagedTraverser.Err when the lease of
+// the traverser expires before all pages are read
+var ErrTraverserExpired = errors.New("Paged traverser is expired")
+
+// PagedTraverser iterates over the results of a traversal which are kept
+// on the server and read page by page, every page read renews the lease of
+// the traverser. It is not safe for concurrent use.
+//
+// Example usage;
+//
+//	traverser, err := neo4jConnection.PagedTraverse(node, desc, TraverseNode, 100, time.Minute)
+//	if err != nil {
+//		return err
+//	}
+//	defer traverser.Close()
+//
+//	for traverser.Next() {
+//		fmt.Println(traverser.Node().ID)
+//	}
+//
+//	if err := traverser.Err(); err != nil {
+//		return err
+//	}
+type PagedTraverser struct {
+	// URL is the location of the traverser on the server
+	URL string
+
+	neo4j      *Neo4j
+	ctx        context.Context
+	returnType string
+	leaseTime  time.Duration
+	lastAccess time.Time
+
+	// current page
+	nodes         []Node
+	relationships []Relationship
+	paths         []Path
+	size          int
+	pos           int
+
+	// first page is returned when the traverser is created
+	fetched bool
+	done    bool
+	err     error
+}
+
+// PagedTraverse creates a traverser on the server which returns the results
+// in pages of the given size, the traverser is removed by the server if it
+// is not accessed for leaseTime. Zero values are left to the server
+// defaults; 50 results and 60 seconds.
+func (neo4j *Neo4j) PagedTraverse(start *Node, desc *TraversalDescription, returnType string, pageSize int, leaseTime time.Duration) (*PagedTraverser, error) {
+	return neo4j.PagedTraverseContext(context.Background(), start, desc, returnType, pageSize, leaseTime)
+}
+
+// PagedTraverseContext is like PagedTraverse but ctx is also used for
+// reading the next pages, the traverser is aborted when it is done
+func (neo4j *Neo4j) PagedTraverseContext(ctx context.Context, start *Node, desc *TraversalDescription, returnType string, pageSize int, leaseTime time.Duration) (*PagedTraverser, error) {
+	traverseURL, encodedBody, err := neo4j.traverseRequest(start, desc, returnType, true)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.Itoa(pageSize))
+	}
+
+	if leaseTime > 0 {
+		params.Set("leaseTime", strconv.Itoa(int(leaseTime.Seconds())))
+	} else {
+		leaseTime = 60 * time.Second
+	}
+
+	if len(params) > 0 {
+		traverseURL += "?" + params.Encode()
+	}
+
+	req, err := neo4j.newRequest(ctx, "POST", traverseURL, encodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := neo4j.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 201 {
+		return nil, newError(res)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	traverser := &PagedTraverser{
+		URL:        res.Header.Get("Location"),
+		neo4j:      neo4j,
+		ctx:        ctx,
+		returnType: returnType,
+		leaseTime:  leaseTime,
+		lastAccess: time.Now(),
+	}
+
+	if err := traverser.setPage(body); err != nil {
+		return nil, err
+	}
+
+	return traverser, nil
+}
+
+// Next advances to the next result, it reads the next page from the server
+// when the current one is consumed. It returns false when there are no
+// more results or an error occurs, see Err
+func (pt *PagedTraverser) Next() bool {
+	if pt.err != nil {
+		return false
+	}
+
+	if pt.fetched {
+		pt.pos++
+	}
+	pt.fetched = true
+
+	for pt.pos >= pt.size {
+		if pt.done || !pt.nextPage() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Node returns the current result of a node traversal
+func (pt *PagedTraverser) Node() *Node {
+	if pt.returnType != TraverseNode || pt.pos >= pt.size {
+		return nil
+	}
+
+	return &pt.nodes[pt.pos]
+}
+
+// Relationship returns the current result of a relationship traversal
+func (pt *PagedTraverser) Relationship() *Relationship {
+	if pt.returnType != TraverseRelationship || pt.pos >= pt.size {
+		return nil
+	}
+
+	return &pt.relationships[pt.pos]
+}
+
+// Path returns the current result of a path traversal
+func (pt *PagedTraverser) Path() *Path {
+	if (pt.returnType != TraversePath && pt.returnType != TraverseFullPath) || pt.pos >= pt.size {
+		return nil
+	}
+
+	return &pt.paths[pt.pos]
+}
+
+// Err returns the error which stopped the iteration
+func (pt *PagedTraverser) Err() error {
+	return pt.err
+}
+
+// Close removes the traverser from the server, traversers which are already
+// removed or expired are ignored
+func (pt *PagedTraverser) Close() error {
+	if pt.done {
+		return nil
+	}
+	pt.done = true
+
+	_, err := pt.neo4j.doRequest(pt.ctx, "DELETE", pt.URL, "")
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// nextPage reads the next page, it returns false when there are no more
+// pages
+func (pt *PagedTraverser) nextPage() bool {
+	// every read advances the traverser on the server, so a read whose
+	// response is lost can not be repeated without skipping a page
+	var response string
+	err := pt.neo4j.retry(pt.ctx, false, func() error {
+		var err error
+		response, err = pt.neo4j.doRequestOnce(pt.ctx, "GET", pt.URL, "")
+		return err
+	})
+	if err != nil {
+		// server responds with 404 both when the traverser is consumed and
+		// when it is expired
+		if IsNotFound(err) {
+			pt.done = true
+			if time.Since(pt.lastAccess) > pt.leaseTime {
+				pt.err = ErrTraverserExpired
+			}
+
+			return false
+		}
+
+		pt.err = err
+		return false
+	}
+
+	pt.lastAccess = time.Now()
+	if err := pt.setPage([]byte(response)); err != nil {
+		pt.err = err
+		return false
+	}
+
+	return true
+}
+
+// setPage decodes the given page and moves to its first result
+func (pt *PagedTraverser) setPage(body []byte) error {
+	items := make([]interface{}, 0)
+	if err := json.Unmarshal(body, &items); err != nil {
+		return err
+	}
+
+	page, err := decodeTraversal(pt.neo4j, items, pt.returnType)
+	if err != nil {
+		return err
+	}
+
+	switch v := page.(type) {
+	case []Node:
+		pt.nodes = v
+	case []Relationship:
+		pt.relationships = v
+	case []Path:
+		pt.paths = v
+	}
+
+	pt.size = len(items)
+	pt.pos = 0
+
+	// an empty page means that the traversal is finished
+	if pt.size == 0 {
+		pt.done = true
+	}
+
+	return nil
+}
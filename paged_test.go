@@ -0,0 +1,213 @@
+package neo4j
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPagedTraverse(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	start := batch.CreateRef(createNewNode())
+	for i := 0; i < 5; i++ {
+		end := batch.CreateRef(createNewNode())
+		batch.Create(&Relationship{Type: "PAGED_TEST", StartNodeID: start.ID(), EndNodeID: end.ID()})
+	}
+
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	desc := NewTraversal().Filter(ReturnAllButStartNode)
+	traverser, err := neo4jConnection.PagedTraverse(start.Node(), desc, TraverseNode, 2, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer traverser.Close()
+
+	count := 0
+	for traverser.Next() {
+		if traverser.Node().ID == "" {
+			t.Error("Node is not valid")
+		}
+		count++
+	}
+
+	if err := traverser.Err(); err != nil {
+		t.Error(err)
+	}
+
+	if count != 5 {
+		t.Error("Traversed node count is not valid", count)
+	}
+}
+
+// pagedServer serves the given pages of a paged traverser
+func pagedServer(t *testing.T, pages [][]string, deleted *bool) *httptest.Server {
+	page := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		base := "http://" + r.Host + "/db/data"
+		traverser := "/db/data/node/1/paged/traverse/node/abc"
+
+		writePage := func() {
+			fmt.Fprint(w, "[")
+			for i, id := range pages[page] {
+				if i > 0 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprintf(w, `{"self" : "%s/node/%s", "data" : {}}`, base, id)
+			}
+			fmt.Fprint(w, "]")
+			page++
+		}
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/db/data/node/1/paged/traverse/node":
+			if r.URL.Query().Get("pageSize") != "2" || r.URL.Query().Get("leaseTime") != "30" {
+				t.Error("Paging parameters are not valid", r.URL.RawQuery)
+			}
+
+			w.Header().Set("Location", "http://"+r.Host+traverser)
+			w.WriteHeader(http.StatusCreated)
+			writePage()
+		case r.Method == "GET" && r.URL.Path == traverser && page < len(pages):
+			writePage()
+		case r.Method == "DELETE" && r.URL.Path == traverser:
+			*deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestPagedTraverserPages(t *testing.T) {
+	deleted := false
+	server := pagedServer(t, [][]string{{"2", "3"}, {"4"}}, &deleted)
+	defer server.Close()
+
+	traverser, err := Connect(server.URL).PagedTraverse(&Node{ID: "1"}, nil, TraverseNode, 2, 30*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := ""
+	for traverser.Next() {
+		ids += traverser.Node().ID
+		if traverser.Relationship() != nil || traverser.Path() != nil {
+			t.Error("Only nodes should be returned")
+		}
+	}
+
+	if ids != "234" {
+		t.Error("Traversed nodes are not valid", ids)
+	}
+
+	if err := traverser.Err(); err != nil {
+		t.Error(err)
+	}
+
+	if traverser.Next() {
+		t.Error("Finished traverser should not advance")
+	}
+
+	if err := traverser.Close(); err != nil || deleted {
+		t.Error("Finished traverser should not be deleted", err, deleted)
+	}
+}
+
+func TestPagedTraverserClose(t *testing.T) {
+	deleted := false
+	server := pagedServer(t, [][]string{{"2", "3"}, {"4", "5"}}, &deleted)
+	defer server.Close()
+
+	traverser, err := Connect(server.URL).PagedTraverse(&Node{ID: "1"}, nil, TraverseNode, 2, 30*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !traverser.Next() {
+		t.Fatal("First page is not read", traverser.Err())
+	}
+
+	if err := traverser.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !deleted {
+		t.Error("Traverser is not deleted")
+	}
+}
+
+func TestPagedTraverserExpired(t *testing.T) {
+	deleted := false
+	server := pagedServer(t, [][]string{{"2"}}, &deleted)
+	defer server.Close()
+
+	traverser, err := Connect(server.URL).PagedTraverse(&Node{ID: "1"}, nil, TraverseNode, 2, 30*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	traverser.lastAccess = time.Now().Add(-time.Minute)
+
+	for traverser.Next() {
+	}
+
+	if traverser.Err() != ErrTraverserExpired {
+		t.Error("Expired traverser is not reported", traverser.Err())
+	}
+}
+
+func TestPagedTraverserLostPage(t *testing.T) {
+	deleted := false
+	pages := pagedServer(t, [][]string{{"2", "3"}, {"4", "5"}, {"6"}}, &deleted)
+	defer pages.Close()
+
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the server reads the second page but its response is lost
+		if r.Method == "GET" && atomic.AddInt32(&gets, 1) == 1 {
+			pages.Config.Handler.ServeHTTP(httptest.NewRecorder(), r)
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+
+		pages.Config.Handler.ServeHTTP(w, r)
+	}))
+	// http.Transport resends the requests failing on a reused connection
+	server.Config.SetKeepAlivesEnabled(false)
+	defer server.Close()
+
+	neo4jConnection, err := ConnectWithOptions(server.URL, WithRetryPolicy(&RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	traverser, err := neo4jConnection.PagedTraverse(&Node{ID: "1"}, nil, TraverseNode, 2, 30*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := ""
+	for traverser.Next() {
+		ids += traverser.Node().ID
+	}
+
+	if ids != "23" || atomic.LoadInt32(&gets) != 1 {
+		t.Error("Lost page should not be skipped by retrying", ids, atomic.LoadInt32(&gets))
+	}
+
+	if traverser.Err() == nil {
+		t.Error("Lost page is not reported", ids, gets, traverser.Err())
+	}
+}
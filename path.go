@@ -0,0 +1,222 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// Path is a sequence of nodes connected with relationships, it is returned
+// by traversals, path finding algorithms and Cypher statements
+//
+// When the path is returned without the full entities only the ids of its
+// nodes and relationships are set, Hydrate reads the rest of them
+type Path struct {
+	Start         *Node
+	End           *Node
+	Nodes         []Node
+	Relationships []Relationship
+	// Length is the number of relationships in the path
+	Length int
+	// Weight is the total cost of the path found by Dijkstra
+	Weight float64
+
+	// neo4j is the connection the path is read from
+	neo4j *Neo4j
+}
+
+// pathResponse is the REST representation of a path, its entities are
+// either urls or full representations
+type pathResponse struct {
+	Start         interface{}   `json:"start"`
+	End           interface{}   `json:"end"`
+	Nodes         []interface{} `json:"nodes"`
+	Relationships []interface{} `json:"relationships"`
+	Length        int           `json:"length"`
+	Weight        float64       `json:"weight"`
+}
+
+// decodePath creates a Path from its REST representation
+func decodePath(neo4j *Neo4j, data interface{}) (*Path, error) {
+	encodedData, err := jsonEncode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &pathResponse{}
+	if err := json.Unmarshal([]byte(encodedData), payload); err != nil {
+		return nil, err
+	}
+
+	path := &Path{
+		Nodes:         make([]Node, len(payload.Nodes)),
+		Relationships: make([]Relationship, len(payload.Relationships)),
+		Length:        payload.Length,
+		Weight:        payload.Weight,
+		neo4j:         neo4j,
+	}
+
+	if path.Start, err = decodePathNode(neo4j, payload.Start); err != nil {
+		return nil, err
+	}
+
+	if path.End, err = decodePathNode(neo4j, payload.End); err != nil {
+		return nil, err
+	}
+
+	for i, value := range payload.Nodes {
+		node, err := decodePathNode(neo4j, value)
+		if err != nil {
+			return nil, err
+		}
+		path.Nodes[i] = *node
+	}
+
+	for i, value := range payload.Relationships {
+		relationship, err := decodePathRelationship(neo4j, value)
+		if err != nil {
+			return nil, err
+		}
+		path.Relationships[i] = *relationship
+	}
+
+	return path, nil
+}
+
+// Hydrate reads the nodes and relationships of the path which are returned
+// without their properties, all of them are read with a single batch
+func (p *Path) Hydrate() error {
+	return p.HydrateContext(context.Background())
+}
+
+// HydrateContext is like Hydrate but aborts the request when ctx is done
+func (p *Path) HydrateContext(ctx context.Context) error {
+	if p.neo4j == nil {
+		return errors.New("Path is not read from Neo4j")
+	}
+
+	return p.neo4j.HydratePathsContext(ctx, p)
+}
+
+// HydratePaths reads the nodes and relationships of the given paths which
+// are returned without their properties, every entity is read once and all
+// of them are read with a single batch
+func (neo4j *Neo4j) HydratePaths(paths ...*Path) error {
+	return neo4j.HydratePathsContext(context.Background(), paths...)
+}
+
+// HydratePathsContext is like HydratePaths but aborts the request when ctx
+// is done
+func (neo4j *Neo4j) HydratePathsContext(ctx context.Context, paths ...*Path) error {
+	// the same entity can be in many paths, it is read once and copied
+	nodes := make(map[string][]*Node)
+	relationships := make(map[string][]*Relationship)
+	batch := neo4j.NewBatch()
+
+	addNode := func(node *Node) {
+		if node == nil || node.Payload != nil {
+			return
+		}
+
+		if _, ok := nodes[node.ID]; !ok {
+			batch.Get(node)
+		}
+		nodes[node.ID] = append(nodes[node.ID], node)
+	}
+
+	for _, path := range paths {
+		addNode(path.Start)
+		addNode(path.End)
+		for i := range path.Nodes {
+			addNode(&path.Nodes[i])
+		}
+
+		for i := range path.Relationships {
+			relationship := &path.Relationships[i]
+			if relationship.Payload != nil {
+				continue
+			}
+
+			if _, ok := relationships[relationship.ID]; !ok {
+				batch.Get(relationship)
+			}
+			relationships[relationship.ID] = append(relationships[relationship.ID], relationship)
+		}
+	}
+
+	if len(batch.Stack) == 0 {
+		return nil
+	}
+
+	if _, err := batch.ExecuteContext(ctx); err != nil {
+		return err
+	}
+
+	for _, copies := range nodes {
+		for _, node := range copies[1:] {
+			*node = *copies[0]
+		}
+	}
+
+	for _, copies := range relationships {
+		for _, relationship := range copies[1:] {
+			*relationship = *copies[0]
+		}
+	}
+
+	return nil
+}
+
+// isPathRepresentation checks if the given value is the REST representation
+// of a path
+func isPathRepresentation(v map[string]interface{}) bool {
+	_, nodes := v["nodes"].([]interface{})
+	_, relationships := v["relationships"].([]interface{})
+	_, length := v["length"].(float64)
+	return nodes && relationships && length && v["start"] != nil && v["end"] != nil
+}
+
+// decodePathNode creates a node from its url or full representation
+func decodePathNode(neo4j *Neo4j, value interface{}) (*Node, error) {
+	node := &Node{}
+
+	switch v := value.(type) {
+	case string:
+		id, err := getIDFromURL(neo4j.NodeURL, v)
+		if err != nil {
+			return nil, err
+		}
+		node.ID = id
+	case map[string]interface{}:
+		if _, err := node.mapBatchResponse(neo4j, v); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("Path node is not valid")
+	}
+
+	return node, nil
+}
+
+// decodePathRelationship creates a relationship from its url or full
+// representation
+func decodePathRelationship(neo4j *Neo4j, value interface{}) (*Relationship, error) {
+	relationship := &Relationship{}
+
+	switch v := value.(type) {
+	case string:
+		id, err := getIDFromURL(neo4j.RelationshipURL, v)
+		if err != nil {
+			return nil, err
+		}
+		relationship.ID = id
+	case map[string]interface{}:
+		if _, err := relationship.mapBatchResponse(neo4j, v); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("Path relationship is not valid")
+	}
+
+	return relationship, nil
+}
@@ -0,0 +1,155 @@
+package neo4j
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCypherReturnsPaths(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	res, err := neo4jConnection.Cypher(`
+        CREATE p = (a {name: {name}})-[:KNOWS]->(b)-[:KNOWS]->(c)
+        RETURN p
+		  `, map[string]interface{}{
+		"name": "cypherPath",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Len() != 1 {
+		t.Fatal(res.Len(), "row count is not valid")
+	}
+
+	path, ok := res.Rows[0][0].(*Path)
+	if !ok {
+		t.Fatal("path is not converted", res.Rows[0][0])
+	}
+
+	if path.Length != 2 || len(path.Nodes) != 3 || len(path.Relationships) != 2 {
+		t.Fatal("path is not valid", path)
+	}
+
+	if path.Start.Data != nil {
+		t.Error("path should not be hydrated", path.Start)
+	}
+
+	if err := path.Hydrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if path.Start.Data["name"] != "cypherPath" || path.Nodes[0].Data["name"] != "cypherPath" {
+		t.Error("path nodes are not hydrated", path.Start, path.Nodes[0])
+	}
+
+	if path.Relationships[0].Type != "KNOWS" {
+		t.Error("path relationships are not hydrated", path.Relationships[0])
+	}
+}
+
+func TestHydratePaths(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/db/data/batch" {
+			t.Error("Hydration is not batched", r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		operations := make([]map[string]interface{}, 0)
+		json.Unmarshal(body, &operations)
+
+		// nodes 1, 2, 3 and relationships 7, 8 are read once
+		if len(operations) != 5 {
+			t.Error("Entities are not read once", len(operations))
+		}
+
+		base := "http://" + r.Host + "/db/data"
+		fmt.Fprint(w, "[")
+		for i, operation := range operations {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+
+			to := operation["to"].(string)
+			id := to[strings.LastIndex(to, "/")+1:]
+			if strings.HasPrefix(to, "/node/") {
+				fmt.Fprintf(w, `{"id":%d,"from":"%s","status":200,"body":{"self":"%s%s","data":{"id":"%s"}}}`, i, to, base, to, id)
+				continue
+			}
+
+			fmt.Fprintf(w, `{"id":%d,"from":"%s","status":200,"body":{"self":"%s%s","start":"%s/node/1","end":"%s/node/2","type":"NEXT","data":{"id":"%s"}}}`, i, to, base, to, base, base, id)
+		}
+		fmt.Fprint(w, "]")
+	}))
+	defer server.Close()
+
+	neo4jConnection := Connect(server.URL)
+	base := server.URL + "/db/data"
+
+	paths := make([]*Path, 2)
+	for i, relationships := range [][]string{{"7"}, {"7", "8"}} {
+		nodes := []interface{}{base + "/node/1", base + "/node/2"}
+		if len(relationships) == 2 {
+			nodes = append(nodes, base+"/node/3")
+		}
+
+		value := map[string]interface{}{
+			"start":         nodes[0],
+			"end":           nodes[len(nodes)-1],
+			"nodes":         nodes,
+			"relationships": []interface{}{},
+			"length":        float64(len(relationships)),
+		}
+		for _, id := range relationships {
+			value["relationships"] = append(value["relationships"].([]interface{}), base+"/relationship/"+id)
+		}
+
+		path, ok := convertValue(neo4jConnection, value).(*Path)
+		if !ok {
+			t.Fatal("path is not converted", value)
+		}
+		paths[i] = path
+	}
+
+	if err := neo4jConnection.HydratePaths(paths...); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 1 {
+		t.Error("Paths are not hydrated with a single request", requests)
+	}
+
+	for _, path := range paths {
+		if path.Start.Data["id"] != "1" || path.End.Data["id"] != path.End.ID {
+			t.Error("Path ends are not hydrated", path.Start, path.End)
+		}
+
+		for _, node := range path.Nodes {
+			if node.Data["id"] != node.ID {
+				t.Error("Path node is not hydrated", node)
+			}
+		}
+
+		for _, relationship := range path.Relationships {
+			if relationship.Data["id"] != relationship.ID || relationship.Type != "NEXT" {
+				t.Error("Path relationship is not hydrated", relationship)
+			}
+		}
+	}
+
+	// hydrated paths are not read again
+	if err := paths[1].Hydrate(); err != nil || requests != 1 {
+		t.Error("Hydrated path is read again", requests, err)
+	}
+
+	if err := (&Path{}).Hydrate(); err == nil {
+		t.Error("Path which is not read from Neo4j can not be hydrated")
+	}
+}
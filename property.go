@@ -0,0 +1,181 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// EntityProperty is used to read and change a single property of a node or
+// a relationship within a Batch, so the other properties are not
+// overwritten like Update does. Only one of Node and Relationship should be
+// set.
+//
+// Operations;
+//
+//	BatchGet    reads the property into Value and the entity's Data
+//	BatchUpdate sets the property to Value
+//	BatchDelete removes the property, if Key is empty all properties of
+//	            the entity are removed
+//
+// Example usage;
+//
+//	batch := neo4jConnection.NewBatch()
+//	batch.SetProperty(node, "visits", 10)
+//	batch.RemoveProperty(relationship, "since")
+//	_, err := batch.Execute()
+type EntityProperty struct {
+	Node         *Node
+	Relationship *Relationship
+	Key          string
+	Value        interface{}
+
+	operation  string
+	requireKey bool
+}
+
+// SetProperty sets the property of the node or relationship as batch
+func (batch *Batch) SetProperty(entity propertyContainer, key string, value interface{}) *Batch {
+	batch.addToStack(BatchUpdate, entity.entityProperty(key, value))
+
+	return batch
+}
+
+// RemoveProperty removes the property of the node or relationship as batch
+func (batch *Batch) RemoveProperty(entity propertyContainer, key string) *Batch {
+	property := entity.entityProperty(key, nil)
+	// an empty key removes all properties, do not let it happen by mistake
+	property.requireKey = true
+	batch.addToStack(BatchDelete, property)
+
+	return batch
+}
+
+// GetProperty reads the property of the node or relationship into its Data
+// as batch
+func (batch *Batch) GetProperty(entity propertyContainer, key string) *Batch {
+	batch.addToStack(BatchGet, entity.entityProperty(key, nil))
+
+	return batch
+}
+
+// RemoveAllProperties removes all properties of the node or relationship as
+// batch
+func (batch *Batch) RemoveAllProperties(entity propertyContainer) *Batch {
+	batch.addToStack(BatchDelete, entity.entityProperty("", nil))
+
+	return batch
+}
+
+// propertyContainer is an entity which has properties, it is implemented by
+// *Node and *Relationship only
+type propertyContainer interface {
+	entityProperty(key string, value interface{}) *EntityProperty
+}
+
+func (n *Node) entityProperty(key string, value interface{}) *EntityProperty {
+	return &EntityProperty{Node: n, Key: key, Value: value}
+}
+
+func (r *Relationship) entityProperty(key string, value interface{}) *EntityProperty {
+	return &EntityProperty{Relationship: r, Key: key, Value: value}
+}
+
+// Implement Batcher interface
+func (ep *EntityProperty) getBatchQuery(operation string) (map[string]interface{}, error) {
+	query := make(map[string]interface{})
+
+	to, err := ep.entityPath()
+	if err != nil {
+		return query, err
+	}
+
+	// only deleting works on all properties
+	if ep.Key == "" && (operation != BatchDelete || ep.requireKey) {
+		return query, errors.New("Property key is not given")
+	}
+
+	ep.operation = operation
+	to += "/properties"
+	if ep.Key != "" {
+		to += "/" + url.PathEscape(ep.Key)
+	}
+
+	switch operation {
+	case BatchGet:
+		query["method"] = "GET"
+	case BatchUpdate:
+		if ep.Value == nil {
+			return query, errors.New("Property value is not given")
+		}
+
+		query["method"] = "PUT"
+		query["body"] = ep.Value
+	case BatchDelete:
+		query["method"] = "DELETE"
+	default:
+		return query, fmt.Errorf("Operation %s is not supported for properties", operation)
+	}
+
+	query["to"] = to
+
+	return query, nil
+}
+
+// entityPath returns the batch path of the node or relationship
+func (ep *EntityProperty) entityPath() (string, error) {
+	if ep.Node != nil && ep.Relationship != nil {
+		return "", errors.New("Only one of node and relationship can be given at once")
+	}
+
+	if ep.Node != nil {
+		if ep.Node.ID == "" {
+			return "", errors.New("Node Id not valid")
+		}
+
+		return nodePath(ep.Node.ID), nil
+	}
+
+	if ep.Relationship != nil {
+		if ep.Relationship.ID == "" {
+			return "", errors.New("Relationship Id not valid")
+		}
+
+		return relationshipPath(ep.Relationship.ID), nil
+	}
+
+	return "", errors.New("Node or relationship is not given")
+}
+
+// data returns the properties of the entity
+func (ep *EntityProperty) data() *map[string]interface{} {
+	if ep.Node != nil {
+		return &ep.Node.Data
+	}
+
+	return &ep.Relationship.Data
+}
+
+func (ep *EntityProperty) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error) {
+	properties := ep.data()
+
+	switch ep.operation {
+	case BatchGet, BatchUpdate:
+		if ep.operation == BatchGet {
+			ep.Value = data
+		}
+
+		if *properties == nil {
+			*properties = make(map[string]interface{})
+		}
+		(*properties)[ep.Key] = ep.Value
+	case BatchDelete:
+		if ep.Key == "" {
+			*properties = make(map[string]interface{})
+		} else {
+			delete(*properties, ep.Key)
+		}
+	}
+
+	return true, nil
+}
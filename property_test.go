@@ -0,0 +1,151 @@
+package neo4j
+
+import (
+	"testing"
+)
+
+func TestNodeProperties(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	ref := batch.CreateRef(createNewNode())
+	batch.SetProperty(ref.Node(), "visits", 1)
+
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a copy without the properties, setting a property must not clobber
+	// the others
+	node := &Node{ID: ref.ID()}
+	batch = neo4jConnection.NewBatch()
+	batch.SetProperty(node, "name", "property test")
+	batch.RemoveProperty(node, "visits")
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if node.Data["name"] != "property test" {
+		t.Error("Property is not set", node.Data)
+	}
+
+	fetched := &Node{ID: ref.ID()}
+	batch = neo4jConnection.NewBatch()
+	batch.GetProperty(fetched, "hede")
+	batch.GetProperty(fetched, "name")
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched.Data["hede"] != "debe" || fetched.Data["name"] != "property test" {
+		t.Error("Properties are not valid", fetched.Data)
+	}
+
+	if _, ok := fetched.Data["visits"]; ok {
+		t.Error("Property is not removed", fetched.Data)
+	}
+
+	if _, err := neo4jConnection.NewBatch().RemoveAllProperties(fetched).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fetched.Data) != 0 {
+		t.Error("Properties are not removed", fetched.Data)
+	}
+
+	fetched = &Node{ID: ref.ID()}
+	if _, err := neo4jConnection.NewBatch().Get(fetched).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fetched.Data) != 0 {
+		t.Error("Properties are not removed on the server", fetched.Data)
+	}
+}
+
+func TestRelationshipProperties(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	start := batch.CreateRef(createNewNode())
+	end := batch.CreateRef(createNewNode())
+	relationship := &Relationship{
+		Type:        "PROPERTY_TEST",
+		StartNodeID: start.ID(),
+		EndNodeID:   end.ID(),
+		Data:        map[string]interface{}{"since": 2015},
+	}
+	batch.Create(relationship)
+
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := neo4jConnection.NewBatch().SetProperty(relationship, "weight", 0.5).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	fetched := &Relationship{ID: relationship.ID}
+	if _, err := neo4jConnection.NewBatch().Get(fetched).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched.Data["since"] != float64(2015) || fetched.Data["weight"] != 0.5 {
+		t.Error("Relationship properties are not valid", fetched.Data)
+	}
+}
+
+func TestEntityPropertyBatchQuery(t *testing.T) {
+	invalid := []struct {
+		operation string
+		property  *EntityProperty
+	}{
+		{BatchUpdate, &EntityProperty{Key: "name", Value: "v"}},
+		{BatchUpdate, &EntityProperty{Key: "name", Value: "v", Node: &Node{}}},
+		{BatchUpdate, &EntityProperty{Key: "name", Value: "v", Node: &Node{ID: "1"}, Relationship: &Relationship{ID: "1"}}},
+		{BatchUpdate, &EntityProperty{Key: "name", Node: &Node{ID: "1"}}},
+		{BatchUpdate, &EntityProperty{Value: "v", Node: &Node{ID: "1"}}},
+		{BatchGet, &EntityProperty{Node: &Node{ID: "1"}}},
+		{BatchDelete, &EntityProperty{Node: &Node{ID: "1"}, requireKey: true}},
+		{BatchCreate, &EntityProperty{Key: "name", Node: &Node{ID: "1"}}},
+	}
+
+	for _, test := range invalid {
+		if _, err := test.property.getBatchQuery(test.operation); err == nil {
+			t.Error("Invalid property operation is accepted", test.operation, test.property)
+		}
+	}
+
+	batch := Connect("").NewBatch()
+	batch.SetProperty(&Relationship{ID: "7"}, "first name", "a")
+	batch.GetProperty(&Node{ID: "{0}"}, "name")
+	batch.RemoveAllProperties(&Node{ID: "3"})
+
+	request, err := batch.prepareRequest(0, len(batch.Stack))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct{ method, to string }{
+		{"PUT", "/relationship/7/properties/first%20name"},
+		{"GET", "{0}/properties/name"},
+		{"DELETE", "/node/3/properties"},
+	}
+
+	for i, query := range request {
+		if query["method"] != expected[i].method || query["to"] != expected[i].to {
+			t.Error("Property query is not valid", query)
+		}
+	}
+
+	if request[0]["body"] != "a" {
+		t.Error("Property value is not sent", request[0]["body"])
+	}
+
+	node := &Node{Data: map[string]interface{}{"name": "a", "age": 3}}
+	property := &EntityProperty{Node: node, Key: "age", operation: BatchDelete}
+	property.mapBatchResponse(nil, nil)
+	if _, ok := node.Data["age"]; ok || node.Data["name"] != "a" {
+		t.Error("Only the removed property should be deleted", node.Data)
+	}
+}
@@ -0,0 +1,211 @@
+package neo4j
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Reference points to the entity created by an operation queued in a Batch.
+// Before the batch is executed its ID is a "{n}" placeholder which Neo4j
+// replaces with the created entity, so it can be used as the start or end
+// node of a relationship, the target of label operations or the entity of
+// index entries in the same batch. After Execute, ID returns the real id
+// and all the placeholders given to the batch operations are replaced with
+// the real ids.
+//
+// Example usage;
+//
+//	batch := neo4jConnection.NewBatch()
+//	alice := batch.CreateRef(&Node{Data: map[string]interface{}{"name": "alice"}})
+//	bob := batch.CreateRef(&Node{Data: map[string]interface{}{"name": "bob"}})
+//
+//	knows := &Relationship{
+//		Type:        "KNOWS",
+//		StartNodeID: alice.ID(),
+//		EndNodeID:   bob.ID(),
+//	}
+//	batch.Create(knows)
+//	batch.AddLabels(alice.Node(), "Person")
+//	batch.IndexAdd("people", "name", "alice", alice.Node())
+//
+//	_, err := batch.Execute()
+type Reference struct {
+	// Data is the entity queued in the batch
+	Data Batcher
+
+	index int
+	id    string
+}
+
+// batchReferrer is implemented by Batchers which can point to entities
+// created in the same batch, it returns the id fields which may hold "{n}"
+// placeholders so they can be replaced with the real ids after Execute
+type batchReferrer interface {
+	batchReferences() []*string
+}
+
+// CreateRef queues the create request like Create and returns a reference
+// to the entity which will be created
+func (batch *Batch) CreateRef(obj Batcher) *Reference {
+	batch.addToStack(BatchCreate, obj)
+
+	ref := &Reference{
+		Data:  obj,
+		index: len(batch.Stack) - 1,
+	}
+	batch.refs = append(batch.refs, ref)
+
+	return ref
+}
+
+// ID returns the id of the created entity if the batch is executed,
+// otherwise its "{n}" placeholder
+func (ref *Reference) ID() string {
+	if ref.id != "" {
+		return ref.id
+	}
+
+	return ref.String()
+}
+
+// String returns the "{n}" placeholder of the reference
+func (ref *Reference) String() string {
+	return "{" + strconv.Itoa(ref.index) + "}"
+}
+
+// Node returns a node pointing to the referenced entity, it can be given to
+// the label and index operations of the same batch
+func (ref *Reference) Node() *Node {
+	return &Node{ID: ref.ID()}
+}
+
+// Relationship returns a relationship pointing to the referenced entity, it
+// can be given to the index operations of the same batch
+func (ref *Reference) Relationship() *Relationship {
+	return &Relationship{ID: ref.ID()}
+}
+
+// resolveReferences replaces the placeholders in the references and
+// operations of the batch with the ids returned in the response
+func (batch *Batch) resolveReferences(response []*BatchResponse) {
+	ids := make(map[int]string, len(response))
+	for _, val := range response {
+		if id := idFromResponse(val); id != "" {
+			ids[val.ID] = id
+		}
+	}
+
+	batch.resolveIDs(ids)
+}
+
+// resolveIDs replaces the placeholders in the references and operations of
+// the batch with the ids of the operations with given indexes
+func (batch *Batch) resolveIDs(ids map[int]string) {
+	for _, ref := range batch.refs {
+		if id, ok := ids[ref.index]; ok {
+			ref.id = id
+		}
+	}
+
+	for _, request := range batch.Stack {
+		referrer, ok := request.Data.(batchReferrer)
+		if !ok {
+			continue
+		}
+
+		for _, id := range referrer.batchReferences() {
+			if !isBatchReference(*id) {
+				continue
+			}
+
+			if resolved, ok := ids[referenceIndex(*id)]; ok {
+				*id = resolved
+			}
+		}
+	}
+}
+
+// idFromResponse returns the id of the created or returned entity
+func idFromResponse(response *BatchResponse) string {
+	url := locationFromResponse(response)
+	if url == "" {
+		return ""
+	}
+
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+// locationFromResponse returns the url of the created or returned entity
+func locationFromResponse(response *BatchResponse) string {
+	if response.Location != "" {
+		return response.Location
+	}
+
+	if body, ok := response.Body.(map[string]interface{}); ok {
+		self, _ := body["self"].(string)
+		return self
+	}
+
+	return ""
+}
+
+// referenceIndex returns n of a "{n}" placeholder
+func referenceIndex(id string) int {
+	index, err := strconv.Atoi(id[1 : len(id)-1])
+	if err != nil {
+		return -1
+	}
+
+	return index
+}
+
+// Implement batchReferrer interface
+func (r *Relationship) batchReferences() []*string {
+	return []*string{&r.ID, &r.StartNodeID, &r.EndNodeID}
+}
+
+// Implement batchReferrer interface
+func (nl *NodeLabels) batchReferences() []*string {
+	if nl.Node == nil {
+		return nil
+	}
+
+	return []*string{&nl.Node.ID}
+}
+
+// Implement batchReferrer interface
+func (ie *IndexEntry) batchReferences() []*string {
+	refs := make([]*string, 0, 1)
+	if ie.Node != nil {
+		refs = append(refs, &ie.Node.ID)
+	}
+
+	if ie.Relationship != nil {
+		refs = append(refs, &ie.Relationship.ID)
+	}
+
+	return refs
+}
+
+// Implement batchReferrer interface
+func (ep *EntityProperty) batchReferences() []*string {
+	refs := make([]*string, 0, 1)
+	if ep.Node != nil {
+		refs = append(refs, &ep.Node.ID)
+	}
+
+	if ep.Relationship != nil {
+		refs = append(refs, &ep.Relationship.ID)
+	}
+
+	return refs
+}
+
+// Implement batchReferrer interface
+func (ur *UniqueRequest) batchReferences() []*string {
+	if referrer, ok := ur.Data.(batchReferrer); ok {
+		return referrer.batchReferences()
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package neo4j
+
+import (
+	"testing"
+)
+
+func TestBatchWithReferences(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	start := batch.CreateRef(createNewNode())
+	end := batch.CreateRef(createNewNode())
+
+	if start.ID() != "{0}" || end.ID() != "{1}" {
+		t.Error("Reference placeholders are not valid", start.ID(), end.ID())
+	}
+
+	relationship := &Relationship{
+		Type:        "REFERENCE_TEST",
+		StartNodeID: start.ID(),
+		EndNodeID:   end.ID(),
+	}
+	batch.Create(relationship)
+
+	labelTarget := start.Node()
+	batch.AddLabels(labelTarget, "ReferenceTest")
+
+	indexTarget := end.Node()
+	batch.IndexAdd("reference_test", "name", "end", indexTarget)
+
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	startNode := start.Data.(*Node)
+	endNode := end.Data.(*Node)
+
+	if start.ID() != startNode.ID || end.ID() != endNode.ID {
+		t.Error("Reference ids are not resolved", start.ID(), end.ID())
+	}
+
+	if relationship.StartNodeID != startNode.ID || relationship.EndNodeID != endNode.ID {
+		t.Error("Relationship node ids are not resolved", relationship.StartNodeID, relationship.EndNodeID)
+	}
+
+	if labelTarget.ID != startNode.ID {
+		t.Error("Label target id is not resolved", labelTarget.ID)
+	}
+
+	if indexTarget.ID != endNode.ID {
+		t.Error("Index target id is not resolved", indexTarget.ID)
+	}
+
+	nodes, err := neo4jConnection.IndexGet("reference_test", "name", "end")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, node := range nodes {
+		if node.ID == endNode.ID {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Error("Referenced node is not indexed")
+	}
+
+	neo4jConnection.DeleteIndex("reference_test")
+}
+
+func TestResolveReferences(t *testing.T) {
+	batch := &Batch{}
+
+	node := batch.CreateRef(&Node{})
+	existing := batch.CreateRef(&Node{})
+	cypher := batch.CreateRef(&Cypher{})
+
+	relationship := &Relationship{StartNodeID: node.ID(), EndNodeID: "{9}"}
+	batch.Create(relationship)
+
+	batch.resolveReferences([]*BatchResponse{
+		{ID: 0, Location: "http://localhost:7474/db/data/node/12"},
+		{ID: 1, Body: map[string]interface{}{"self": "http://localhost:7474/db/data/node/34"}},
+		{ID: 2, Body: map[string]interface{}{"columns": []interface{}{}}},
+	})
+
+	if node.ID() != "12" {
+		t.Error("Id is not taken from location", node.ID())
+	}
+
+	if existing.ID() != "34" {
+		t.Error("Id is not taken from self", existing.ID())
+	}
+
+	if cypher.ID() != "{2}" {
+		t.Error("Reference without entity should keep its placeholder", cypher.ID())
+	}
+
+	if relationship.StartNodeID != "12" {
+		t.Error("Start node id is not resolved", relationship.StartNodeID)
+	}
+
+	if relationship.EndNodeID != "{9}" {
+		t.Error("Unknown references should not be changed", relationship.EndNodeID)
+	}
+}
+
+func TestReferencesInBatchPaths(t *testing.T) {
+	batch := &Batch{}
+
+	node := batch.CreateRef(&Node{})
+	relationship := batch.CreateRef(&Relationship{StartNodeID: node.ID(), EndNodeID: node.ID(), Type: "KNOWS"})
+
+	queries := []struct {
+		data      Batcher
+		operation string
+		to        string
+	}{
+		{node.Node(), BatchGet, "{0}"},
+		{node.Node(), BatchDelete, "{0}"},
+		{node.Node(), BatchUpdate, "{0}/properties"},
+		{relationship.Relationship(), BatchGet, "{1}"},
+		{relationship.Relationship(), BatchDelete, "{1}"},
+		{relationship.Relationship(), BatchUpdate, "{1}/properties"},
+	}
+
+	for _, query := range queries {
+		request, err := query.data.getBatchQuery(query.operation)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if request["to"] != query.to {
+			t.Error("Reference is not used in the path", query.operation, request["to"])
+		}
+	}
+}
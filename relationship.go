@@ -1,12 +1,15 @@
 package neo4j
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 )
 
 // Relationship struct
+// StartNodeID and EndNodeID can be references to the nodes created in the
+// same batch, see Reference
 type Relationship struct {
 	ID          string
 	StartNodeID string
@@ -27,11 +30,34 @@ type RelationshipResponse struct {
 	Data       map[string]interface{} `json:"data"`
 }
 
+// NewRelationshipFrom creates a relationship with the properties of the
+// given struct, Type, StartNodeID and EndNodeID must be set before creating
+// it. See Node.Scan for the property mapping rules
+func NewRelationshipFrom(src interface{}) (*Relationship, error) {
+	data, err := propertiesFrom(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Relationship{Data: data}, nil
+}
+
+// Scan copies relationship properties into the struct dst points to, see
+// Node.Scan for the property mapping rules
+func (r *Relationship) Scan(dst interface{}) error {
+	return scanProperties(r.Data, dst)
+}
+
 // GetRelationshipTypes queries Neo4J for all relationships
 func (neo4j *Neo4j) GetRelationshipTypes() ([]string, error) {
-	url := fmt.Sprintf("%s/types", neo4j.RelationshipURL)
+	return neo4j.GetRelationshipTypesContext(context.Background())
+}
+
+// GetRelationshipTypesContext is like GetRelationshipTypes but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) GetRelationshipTypesContext(ctx context.Context) ([]string, error) {
 	var result = make([]string, 0)
-	response, err := neo4j.doRequest("GET", url, "")
+	response, err := neo4j.doRequest(ctx, "GET", neo4j.RelationshipTypesURL, "")
 	if err != nil {
 		return result, err
 	}
@@ -45,6 +71,11 @@ func (neo4j *Neo4j) GetRelationshipTypes() ([]string, error) {
 }
 
 func (r *Relationship) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error) {
+	// update and delete operations do not return the relationship
+	if data == nil {
+		return true, nil
+	}
+
 	// because data is a map, convert back to Json
 	encodedData, err := jsonEncode(data)
 	result, err := r.decode(neo4j, encodedData)
@@ -84,7 +115,7 @@ func prepareRelationshipGetBatchMap(r *Relationship) (map[string]interface{}, er
 	}
 
 	query["method"] = "GET"
-	query["to"] = fmt.Sprintf("/relationship/%s", r.ID)
+	query["to"] = relationshipPath(r.ID)
 
 	return query, nil
 }
@@ -97,7 +128,7 @@ func prepareRelationshipDeleteBatchMap(r *Relationship) (map[string]interface{},
 	}
 
 	query["method"] = "DELETE"
-	query["to"] = fmt.Sprintf("/relationship/%s", r.ID)
+	query["to"] = relationshipPath(r.ID)
 
 	return query, nil
 }
@@ -118,8 +149,8 @@ func prepareRelationshipCreateBatchMap(r *Relationship) (map[string]interface{},
 		return query, errors.New("Relationship type is not valid")
 	}
 
-	url := fmt.Sprintf("/node/%s/relationships", r.StartNodeID)
-	endNodeURL := fmt.Sprintf("/node/%s", r.EndNodeID)
+	url := nodePath(r.StartNodeID) + "/relationships"
+	endNodeURL := nodePath(r.EndNodeID)
 
 	return map[string]interface{}{
 		"method": "POST",
@@ -147,8 +178,8 @@ func prepareRelationshipCreateUniqueBatchMap(r *Relationship) (map[string]interf
 		return query, errors.New("Relationship type is not valid")
 	}
 
-	startURL := fmt.Sprintf("/node/%s", r.StartNodeID)
-	endNodeURL := fmt.Sprintf("/node/%s", r.EndNodeID)
+	startURL := nodePath(r.StartNodeID)
+	endNodeURL := nodePath(r.EndNodeID)
 
 	return map[string]interface{}{
 		"method": "POST",
@@ -171,7 +202,7 @@ func prepareRelationshipUpdateBatchMap(r *Relationship) (map[string]interface{},
 
 	query = map[string]interface{}{
 		"method": "PUT",
-		"to":     fmt.Sprintf("/relationship/%s/properties", r.ID),
+		"to":     relationshipPath(r.ID) + "/properties",
 		"body":   r.Data,
 	}
 
@@ -180,48 +211,87 @@ func prepareRelationshipUpdateBatchMap(r *Relationship) (map[string]interface{},
 
 // GetOutgoingRelationships queries outgoing relationships for a node
 func (neo4j *Neo4j) GetOutgoingRelationships(node *Node) ([]Relationship, error) {
-	res, err := getRelationships(neo4j, node, "out")
+	return neo4j.GetOutgoingRelationshipsContext(context.Background(), node)
+}
+
+// GetOutgoingRelationshipsContext is like GetOutgoingRelationships but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) GetOutgoingRelationshipsContext(ctx context.Context, node *Node) ([]Relationship, error) {
+	res, err := getRelationships(ctx, neo4j, node, DirectionOut)
 	return res, err
 }
 
 // GetAllRelationships queries all relationships for a node
 func (neo4j *Neo4j) GetAllRelationships(node *Node) ([]Relationship, error) {
-	res, err := getRelationships(neo4j, node, "all")
+	return neo4j.GetAllRelationshipsContext(context.Background(), node)
+}
+
+// GetAllRelationshipsContext is like GetAllRelationships but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) GetAllRelationshipsContext(ctx context.Context, node *Node) ([]Relationship, error) {
+	res, err := getRelationships(ctx, neo4j, node, DirectionAll)
 	return res, err
 }
 
 // GetIncomingRelationships queries incoming realtionships for a node
 func (neo4j *Neo4j) GetIncomingRelationships(node *Node) ([]Relationship, error) {
-	res, err := getRelationships(neo4j, node, "in")
+	return neo4j.GetIncomingRelationshipsContext(context.Background(), node)
+}
+
+// GetIncomingRelationshipsContext is like GetIncomingRelationships but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) GetIncomingRelationshipsContext(ctx context.Context, node *Node) ([]Relationship, error) {
+	res, err := getRelationships(ctx, neo4j, node, DirectionIn)
 	return res, err
 }
 
 // GetOutgoingTypedRelationships queries TYPED outgoing relationships for a node
 func (neo4j *Neo4j) GetOutgoingTypedRelationships(node *Node, relType string) ([]Relationship, error) {
-	res, err := getRelationships(neo4j, node, fmt.Sprintf("out/%s", relType))
+	return neo4j.GetOutgoingTypedRelationshipsContext(context.Background(), node, relType)
+}
+
+// GetOutgoingTypedRelationshipsContext is like GetOutgoingTypedRelationships but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) GetOutgoingTypedRelationshipsContext(ctx context.Context, node *Node, relType string) ([]Relationship, error) {
+	res, err := getRelationships(ctx, neo4j, node, DirectionOut+"/"+relType)
 	return res, err
 }
 
 // GetAllTypedRelationships queries all TYPED relationships for a node
 func (neo4j *Neo4j) GetAllTypedRelationships(node *Node, relType string) ([]Relationship, error) {
-	res, err := getRelationships(neo4j, node, fmt.Sprintf("all/%s", relType))
+	return neo4j.GetAllTypedRelationshipsContext(context.Background(), node, relType)
+}
+
+// GetAllTypedRelationshipsContext is like GetAllTypedRelationships but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) GetAllTypedRelationshipsContext(ctx context.Context, node *Node, relType string) ([]Relationship, error) {
+	res, err := getRelationships(ctx, neo4j, node, DirectionAll+"/"+relType)
 	return res, err
 }
 
 // GetIncomingTypedRelationships queries TYPED incoming realtionships for a node
 func (neo4j *Neo4j) GetIncomingTypedRelationships(node *Node, relType string) ([]Relationship, error) {
-	res, err := getRelationships(neo4j, node, fmt.Sprintf("in/%s", relType))
+	return neo4j.GetIncomingTypedRelationshipsContext(context.Background(), node, relType)
+}
+
+// GetIncomingTypedRelationshipsContext is like GetIncomingTypedRelationships but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) GetIncomingTypedRelationshipsContext(ctx context.Context, node *Node, relType string) ([]Relationship, error) {
+	res, err := getRelationships(ctx, neo4j, node, DirectionIn+"/"+relType)
 	return res, err
 }
 
-func getRelationships(neo4j *Neo4j, node *Node, direction string) ([]Relationship, error) {
+func getRelationships(ctx context.Context, neo4j *Neo4j, node *Node, direction string) ([]Relationship, error) {
 	if node.ID == "" {
 		return nil, errors.New("Id is not given")
 	}
 
 	customReq := &ManuelBatchRequest{}
 	customReq.To = fmt.Sprintf("/node/%s/relationships/%s", node.ID, direction)
-	neo4j.NewBatch().Get(customReq).Execute()
+	if _, err := neo4j.NewBatch().Get(customReq).ExecuteContext(ctx); err != nil {
+		return nil, err
+	}
+
 	result := []Relationship{}
 	err := neo4j.GetManualBatchResponse(customReq, &result)
 	if err != nil {
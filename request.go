@@ -1,6 +1,7 @@
 package neo4j
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -11,16 +12,35 @@ import (
 
 // ManuelRequest struct for create a custom Neo4J request
 // This is particularly used for creating Batch opeartion requests
+//
+// Create it with Neo4j.NewManuelRequest so the client, credentials and user
+// agent of the connection are used. If Neo4j is not set the request is sent
+// with http.DefaultClient without any of the connection options
 type ManuelRequest struct {
+	Neo4j  *Neo4j
 	To     string
 	Params map[string]string
 	Body   map[string]string
 }
 
+// NewManuelRequest creates a custom request to the given url which is sent
+// with the client and credentials of the connection
+func (neo4j *Neo4j) NewManuelRequest(to string) *ManuelRequest {
+	return &ManuelRequest{
+		Neo4j: neo4j,
+		To:    to,
+	}
+}
+
 // Get func
 func (mr *ManuelRequest) Get() ([]string, error) {
+	return mr.GetContext(context.Background())
+}
+
+// GetContext is like Get but aborts the request when ctx is done
+func (mr *ManuelRequest) GetContext(ctx context.Context) ([]string, error) {
 	urlWithParams := mr.encodeParams()
-	req, err := http.NewRequest("GET", urlWithParams, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", urlWithParams, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -35,8 +55,13 @@ func (mr *ManuelRequest) Get() ([]string, error) {
 
 // Delete func
 func (mr *ManuelRequest) Delete() error {
+	return mr.DeleteContext(context.Background())
+}
+
+// DeleteContext is like Delete but aborts the request when ctx is done
+func (mr *ManuelRequest) DeleteContext(ctx context.Context) error {
 	urlWithParams := mr.encodeParams()
-	req, err := http.NewRequest("DELETE", urlWithParams, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", urlWithParams, nil)
 	if err != nil {
 		return err
 	}
@@ -50,11 +75,11 @@ func (mr *ManuelRequest) Delete() error {
 }
 
 func (mr *ManuelRequest) getDeleteHelper(req *http.Request) ([]string, error) {
-	client := &http.Client{}
-	res, err := client.Do(req)
+	res, err := mr.do(req)
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
 	resp, err := mr.decodeResponse(res)
 	if err != nil {
@@ -66,6 +91,11 @@ func (mr *ManuelRequest) getDeleteHelper(req *http.Request) ([]string, error) {
 
 // Post func
 func (mr *ManuelRequest) Post() error {
+	return mr.PostContext(context.Background())
+}
+
+// PostContext is like Post but aborts the request when ctx is done
+func (mr *ManuelRequest) PostContext(ctx context.Context) error {
 	body, err := jsonEncode(mr.Body)
 	if err != nil {
 		return err
@@ -78,25 +108,44 @@ func (mr *ManuelRequest) Post() error {
 	}
 
 	mr.encodeForm(req)
-	client := &http.Client{}
-	res, err := client.PostForm(mr.To, req.Form)
+
+	// body is sent as form like http.PostForm does
+	req, err = http.NewRequestWithContext(ctx, "POST", mr.To, strings.NewReader(req.Form.Encode()))
 	if err != nil {
 		return err
 	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	_, err = mr.decodeResponse(res)
+	res, err := mr.do(req)
 	if err != nil {
 		return err
 	}
-
 	defer res.Body.Close()
 
+	_, err = mr.decodeResponse(res)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// do sends the request with the client of the connection
+func (mr *ManuelRequest) do(req *http.Request) (*http.Response, error) {
+	if mr.Neo4j == nil {
+		return http.DefaultClient.Do(req)
+	}
+
+	if err := mr.Neo4j.setHeaders(req); err != nil {
+		return nil, err
+	}
+
+	return mr.Neo4j.Client.Do(req)
+}
+
 func (mr *ManuelRequest) decodeResponse(res *http.Response) ([]string, error) {
 	switch res.StatusCode {
-	case 200, 500:
+	case 200:
 		body, err := ioutil.ReadAll(res.Body)
 		if err != nil {
 			return nil, err
@@ -111,7 +160,7 @@ func (mr *ManuelRequest) decodeResponse(res *http.Response) ([]string, error) {
 		return result, nil
 	case 204:
 	default:
-		return nil, fmt.Errorf(res.Status)
+		return nil, newError(res)
 	}
 
 	return nil, nil
@@ -0,0 +1,100 @@
+package neo4j
+
+// Result holds the rows returned for a Cypher statement
+type Result struct {
+	// Columns are the column names in the order of the RETURN clause
+	Columns []string
+	// Rows are the returned records, every row has a value for each column
+	Rows [][]interface{}
+}
+
+// Len returns the number of rows in the result
+func (r *Result) Len() int {
+	return len(r.Rows)
+}
+
+// ColumnIndex returns the position of the given column in a row, -1 if the
+// column is not returned
+func (r *Result) ColumnIndex(name string) int {
+	for i, column := range r.Columns {
+		if column == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// newResult creates a Result from the rows in REST format, node,
+// relationship and path representations are converted into *Node,
+// *Relationship and *Path
+func newResult(neo4j *Neo4j, columns []string, rows [][]interface{}) *Result {
+	result := &Result{
+		Columns: columns,
+		Rows:    make([][]interface{}, len(rows)),
+	}
+
+	for i, row := range rows {
+		result.Rows[i] = make([]interface{}, len(row))
+		for j, value := range row {
+			result.Rows[i][j] = convertValue(neo4j, value)
+		}
+	}
+
+	return result
+}
+
+// convertValue converts the given value into a *Node, *Relationship or *Path
+// if it is their REST representation, collections are converted recursively
+func convertValue(neo4j *Neo4j, value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = convertValue(neo4j, item)
+		}
+		return converted
+	case map[string]interface{}:
+		if isPathRepresentation(v) {
+			if path, err := decodePath(neo4j, v); err == nil {
+				return path
+			}
+		}
+
+		if isRelationshipRepresentation(v) {
+			relationship := &Relationship{}
+			if ok, err := relationship.mapBatchResponse(neo4j, v); ok && err == nil {
+				return relationship
+			}
+		}
+
+		if isNodeRepresentation(v) {
+			node := &Node{}
+			if ok, err := node.mapBatchResponse(neo4j, v); ok && err == nil {
+				return node
+			}
+		}
+
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[key] = convertValue(neo4j, item)
+		}
+		return converted
+	}
+
+	return value
+}
+
+func isNodeRepresentation(v map[string]interface{}) bool {
+	_, self := v["self"].(string)
+	_, outgoing := v["outgoing_relationships"].(string)
+	return self && outgoing
+}
+
+func isRelationshipRepresentation(v map[string]interface{}) bool {
+	_, self := v["self"].(string)
+	_, start := v["start"].(string)
+	_, end := v["end"].(string)
+	_, typ := v["type"].(string)
+	return self && start && end && typ
+}
@@ -0,0 +1,169 @@
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RetryPolicy configures retrying the requests which fail because of
+// transient problems. It is used when set as Neo4j.RetryPolicy.
+//
+// Idempotent requests, GETs and batches consisting of only GET operations,
+// are retried after network errors and 502, 503 and 504 responses. All
+// requests are retried when Neo4j reports a transient error like a
+// detected deadlock, since the failed operation is rolled back by Neo4j.
+// Requests of the transactions opened with Begin are not retried.
+//
+// Waiting time before the nth retry is InitialBackoff * Multiplier^(n-1)
+// limited by MaxBackoff, Jitter of it is randomized.
+//
+// Example usage;
+//
+//	policy := DefaultRetryPolicy()
+//	policy.OnRetry = func(attempt int, err error, wait time.Duration) {
+//		log.Printf("retry %d in %s: %s", attempt, wait, err)
+//	}
+//	neo4jConnection.RetryPolicy = policy
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+
+	// InitialBackoff is 100ms, MaxBackoff is 5s and Multiplier is 2 if not
+	// set
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+
+	// Jitter is the ratio of the waiting time which is randomized, it
+	// should be between 0 and 1. The waiting time is not randomized if it
+	// is zero
+	Jitter float64
+
+	// OnRetry is called before waiting for the next attempt if it is set,
+	// attempt starts from 1
+	OnRetry func(attempt int, err error, wait time.Duration)
+}
+
+// DefaultRetryPolicy returns a policy retrying 3 times with randomized
+// exponential backoff
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+}
+
+// retry calls fn until it succeeds or the error can not be retried anymore
+func (neo4j *Neo4j) retry(ctx context.Context, idempotent bool, fn func() error) error {
+	policy := neo4j.RetryPolicy
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || policy == nil || attempt > policy.MaxRetries {
+			return err
+		}
+
+		if ctx.Err() != nil || !isRetryable(err, idempotent) {
+			return err
+		}
+
+		wait := policy.backoff(attempt)
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt, err, wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the waiting time before the given retry
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	initial := policy.InitialBackoff
+	if initial <= 0 {
+		initial = 100 * time.Millisecond
+	}
+
+	max := policy.MaxBackoff
+	if max <= 0 {
+		max = 5 * time.Second
+	}
+
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	wait := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+	if wait > float64(max) {
+		wait = float64(max)
+	}
+
+	if policy.Jitter > 0 {
+		wait -= wait * math.Min(policy.Jitter, 1) * rand.Float64()
+	}
+
+	return time.Duration(wait)
+}
+
+// isRetryable checks if the request failed with err can be sent again
+func isRetryable(err error, idempotent bool) bool {
+	if IsTransient(err) {
+		return true
+	}
+
+	return idempotent && isUnavailable(err)
+}
+
+// isUnavailable checks if err is caused by a server which is not reachable
+// or temporarily can not serve the requests
+func isUnavailable(err error) bool {
+	var nerr *Neo4jError
+	if errors.As(err, &nerr) {
+		switch nerr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+
+		return false
+	}
+
+	// url.Error implements net.Error, so it is unwrapped to not retry errors
+	// like certificate verification failures or unsupported schemes
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	// connection refused while dialing or reset while reading
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial" || opErr.Op == "read"
+	}
+
+	return false
+}
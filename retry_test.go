@@ -0,0 +1,158 @@
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryIdempotentRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprint(w, `["Person"]`)
+	}))
+	defer server.Close()
+
+	retries := 0
+	neo4jConnection := Connect(server.URL)
+	neo4jConnection.RetryPolicy = &RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		Jitter:         0.5,
+		OnRetry: func(attempt int, err error, wait time.Duration) {
+			retries++
+			if attempt != retries {
+				t.Error("Attempt is not valid", attempt)
+			}
+
+			if wait > time.Duration(attempt)*2*time.Millisecond {
+				t.Error("Waiting time is not valid", wait)
+			}
+		},
+	}
+
+	labels, err := neo4jConnection.GetAllLabels()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 1 || retries != 2 {
+		t.Error("Request is not retried", labels, retries)
+	}
+}
+
+func TestRetryDoesNotRepeatWrites(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	neo4jConnection := Connect(server.URL)
+	neo4jConnection.RetryPolicy = &RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond}
+
+	_, err := neo4jConnection.CreateSchemaIndex("Person", "name")
+	if err == nil {
+		t.Fatal("Request should fail")
+	}
+
+	if requests != 1 {
+		t.Error("Write request is retried", requests)
+	}
+}
+
+func TestRetryDoesNotRepeatCertificateErrors(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `["Person"]`)
+	}))
+	defer server.Close()
+
+	retries := 0
+	neo4jConnection := Connect(server.URL)
+	neo4jConnection.RetryPolicy = &RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		OnRetry: func(attempt int, err error, wait time.Duration) {
+			retries++
+		},
+	}
+
+	// certificate of the test server is not trusted by the default client
+	_, err := neo4jConnection.GetAllLabels()
+	if err == nil {
+		t.Fatal("Request should fail")
+	}
+
+	if retries != 0 {
+		t.Error("Request is retried after a certificate error", retries, err)
+	}
+}
+
+func TestRetryBatchOnDeadlock(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			fmt.Fprint(w, `[{"id":0,"from":"/node","body":{"message":"Deadlock","exception":"DeadlockDetectedException"},"status":500}]`)
+			return
+		}
+
+		self := "http://" + r.Host + "/db/data/node/5"
+		fmt.Fprintf(w, `[{"id":0,"from":"/node","location":"%s","body":{"self":"%s","data":{}},"status":201}]`, self, self)
+	}))
+	defer server.Close()
+
+	neo4jConnection := Connect(server.URL)
+	neo4jConnection.RetryPolicy = &RetryPolicy{MaxRetries: 1, InitialBackoff: time.Millisecond}
+
+	node := &Node{}
+	res, err := neo4jConnection.NewBatch().Create(node).Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 2 || len(res) != 1 || node.ID != "5" {
+		t.Error("Batch is not retried", requests, len(res), node.ID)
+	}
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	neo4jConnection := Connect("")
+	neo4jConnection.RetryPolicy = &RetryPolicy{MaxRetries: 5, InitialBackoff: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	unavailable := &Neo4jError{StatusCode: http.StatusServiceUnavailable, Index: -1}
+	err := neo4jConnection.retry(ctx, true, func() error {
+		calls++
+		return unavailable
+	})
+
+	if !errors.Is(err, unavailable) || calls != 1 {
+		t.Error("Retry should stop when context is done", err, calls)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second, Multiplier: 2}
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}
+	for i, wait := range expected {
+		if backoff := policy.backoff(i + 1); backoff != wait {
+			t.Error("Backoff is not valid", i+1, backoff)
+		}
+	}
+}
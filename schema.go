@@ -0,0 +1,304 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ConstraintUniqueness is the type of uniqueness constraints
+const ConstraintUniqueness = "UNIQUENESS"
+
+// SchemaIndex struct is an index on a property of the nodes with a label
+type SchemaIndex struct {
+	Label        string   `json:"label"`
+	PropertyKeys []string `json:"property_keys"`
+}
+
+// Constraint struct is a constraint on a property of the nodes with a label,
+// only uniqueness constraints are supported by Neo4j
+type Constraint struct {
+	Label        string   `json:"label"`
+	Type         string   `json:"type"`
+	PropertyKeys []string `json:"property_keys"`
+}
+
+// Schema struct describes the indexes and uniqueness constraints which
+// should exist in the database, it is used by EnsureSchema
+type Schema struct {
+	Indexes     []SchemaIndex
+	Constraints []Constraint
+}
+
+// CreateSchemaIndex creates an index on the property of the nodes with label
+func (neo4j *Neo4j) CreateSchemaIndex(label, property string) (*SchemaIndex, error) {
+	return neo4j.CreateSchemaIndexContext(context.Background(), label, property)
+}
+
+// CreateSchemaIndexContext is like CreateSchemaIndex but aborts the request
+// when ctx is done
+func (neo4j *Neo4j) CreateSchemaIndexContext(ctx context.Context, label, property string) (*SchemaIndex, error) {
+	if err := validateSchemaItem(label, property); err != nil {
+		return nil, err
+	}
+
+	index := &SchemaIndex{}
+	err := neo4j.postSchema(ctx, neo4j.schemaURL("index", label), property, index)
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// GetSchemaIndexes returns the indexes of the nodes with label, or all
+// indexes if label is empty
+func (neo4j *Neo4j) GetSchemaIndexes(label string) ([]*SchemaIndex, error) {
+	return neo4j.GetSchemaIndexesContext(context.Background(), label)
+}
+
+// GetSchemaIndexesContext is like GetSchemaIndexes but aborts the request
+// when ctx is done
+func (neo4j *Neo4j) GetSchemaIndexesContext(ctx context.Context, label string) ([]*SchemaIndex, error) {
+	result := make([]*SchemaIndex, 0)
+	response, err := neo4j.doRequest(ctx, "GET", neo4j.schemaURL("index", label), "")
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal([]byte(response), &result)
+	return result, err
+}
+
+// DropSchemaIndex drops the index on the property of the nodes with label
+func (neo4j *Neo4j) DropSchemaIndex(label, property string) error {
+	return neo4j.DropSchemaIndexContext(context.Background(), label, property)
+}
+
+// DropSchemaIndexContext is like DropSchemaIndex but aborts the request when
+// ctx is done
+func (neo4j *Neo4j) DropSchemaIndexContext(ctx context.Context, label, property string) error {
+	if err := validateSchemaItem(label, property); err != nil {
+		return err
+	}
+
+	indexURL := neo4j.schemaURL("index", label) + "/" + url.PathEscape(property)
+	_, err := neo4j.doRequest(ctx, "DELETE", indexURL, "")
+	return err
+}
+
+// CreateUniqueConstraint creates a uniqueness constraint on the property of
+// the nodes with label
+func (neo4j *Neo4j) CreateUniqueConstraint(label, property string) (*Constraint, error) {
+	return neo4j.CreateUniqueConstraintContext(context.Background(), label, property)
+}
+
+// CreateUniqueConstraintContext is like CreateUniqueConstraint but aborts
+// the request when ctx is done
+func (neo4j *Neo4j) CreateUniqueConstraintContext(ctx context.Context, label, property string) (*Constraint, error) {
+	if err := validateSchemaItem(label, property); err != nil {
+		return nil, err
+	}
+
+	constraint := &Constraint{}
+	constraintURL := neo4j.schemaURL("constraint", label) + "/uniqueness"
+	if err := neo4j.postSchema(ctx, constraintURL, property, constraint); err != nil {
+		return nil, err
+	}
+
+	return constraint, nil
+}
+
+// GetConstraints returns the constraints of the nodes with label, or all
+// constraints if label is empty
+func (neo4j *Neo4j) GetConstraints(label string) ([]*Constraint, error) {
+	return neo4j.GetConstraintsContext(context.Background(), label)
+}
+
+// GetConstraintsContext is like GetConstraints but aborts the request when
+// ctx is done
+func (neo4j *Neo4j) GetConstraintsContext(ctx context.Context, label string) ([]*Constraint, error) {
+	result := make([]*Constraint, 0)
+	response, err := neo4j.doRequest(ctx, "GET", neo4j.schemaURL("constraint", label), "")
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal([]byte(response), &result)
+	return result, err
+}
+
+// DropUniqueConstraint drops the uniqueness constraint on the property of
+// the nodes with label
+func (neo4j *Neo4j) DropUniqueConstraint(label, property string) error {
+	return neo4j.DropUniqueConstraintContext(context.Background(), label, property)
+}
+
+// DropUniqueConstraintContext is like DropUniqueConstraint but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) DropUniqueConstraintContext(ctx context.Context, label, property string) error {
+	if err := validateSchemaItem(label, property); err != nil {
+		return err
+	}
+
+	constraintURL := neo4j.schemaURL("constraint", label) + "/uniqueness/" + url.PathEscape(property)
+	_, err := neo4j.doRequest(ctx, "DELETE", constraintURL, "")
+	return err
+}
+
+// EnsureSchema compares the given schema with the one in the database and
+// creates only the missing indexes and constraints. Existing indexes and
+// constraints which are not in the schema are left untouched.
+//
+// A uniqueness constraint is backed by an index, so an index in the schema
+// is satisfied by a constraint on the same property. Neo4j does not allow
+// creating a constraint on an indexed property, so such indexes are
+// dropped before their constraints are created, and created again if the
+// constraint can not be created, e.g. because of duplicate values.
+//
+// Example usage;
+//
+//	err := neo4jConnection.EnsureSchema(&Schema{
+//		Indexes: []SchemaIndex{
+//			{Label: "Person", PropertyKeys: []string{"name"}},
+//		},
+//		Constraints: []Constraint{
+//			{Label: "Person", PropertyKeys: []string{"email"}},
+//		},
+//	})
+func (neo4j *Neo4j) EnsureSchema(schema *Schema) error {
+	return neo4j.EnsureSchemaContext(context.Background(), schema)
+}
+
+// EnsureSchemaContext is like EnsureSchema but aborts the requests when ctx
+// is done
+func (neo4j *Neo4j) EnsureSchemaContext(ctx context.Context, schema *Schema) error {
+	indexes, err := neo4j.GetSchemaIndexesContext(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	constraints, err := neo4j.GetConstraintsContext(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	existingIndexes := make(map[string]bool)
+	for _, index := range indexes {
+		for _, property := range index.PropertyKeys {
+			existingIndexes[schemaKey(index.Label, property)] = true
+		}
+	}
+
+	existingConstraints := make(map[string]bool)
+	for _, constraint := range constraints {
+		if constraint.Type != "" && constraint.Type != ConstraintUniqueness {
+			continue
+		}
+
+		for _, property := range constraint.PropertyKeys {
+			existingConstraints[schemaKey(constraint.Label, property)] = true
+		}
+	}
+
+	for _, constraint := range schema.Constraints {
+		if constraint.Type != "" && constraint.Type != ConstraintUniqueness {
+			return fmt.Errorf("Constraint type %s is not supported", constraint.Type)
+		}
+
+		for _, property := range constraint.PropertyKeys {
+			key := schemaKey(constraint.Label, property)
+			if existingConstraints[key] {
+				continue
+			}
+
+			if existingIndexes[key] {
+				if err := neo4j.DropSchemaIndexContext(ctx, constraint.Label, property); err != nil {
+					return err
+				}
+			}
+
+			if _, err := neo4j.CreateUniqueConstraintContext(ctx, constraint.Label, property); err != nil {
+				if !existingIndexes[key] {
+					return err
+				}
+
+				// do not lose the dropped index
+				if _, indexErr := neo4j.CreateSchemaIndexContext(ctx, constraint.Label, property); indexErr != nil {
+					return fmt.Errorf("%w (restoring the index failed: %v)", err, indexErr)
+				}
+
+				return err
+			}
+
+			existingConstraints[key] = true
+			existingIndexes[key] = true
+		}
+	}
+
+	for _, index := range schema.Indexes {
+		for _, property := range index.PropertyKeys {
+			key := schemaKey(index.Label, property)
+			if existingIndexes[key] || existingConstraints[key] {
+				continue
+			}
+
+			if _, err := neo4j.CreateSchemaIndexContext(ctx, index.Label, property); err != nil {
+				return err
+			}
+
+			existingIndexes[key] = true
+		}
+	}
+
+	return nil
+}
+
+// schemaURL returns the url of the schema items for label, or all of them
+// if label is empty
+func (neo4j *Neo4j) schemaURL(kind, label string) string {
+	schemaURL := neo4j.SchemaIndexURL
+	if kind == "constraint" {
+		schemaURL = neo4j.ConstraintURL
+	}
+
+	if label != "" {
+		schemaURL += "/" + url.PathEscape(label)
+	}
+
+	return schemaURL
+}
+
+func (neo4j *Neo4j) postSchema(ctx context.Context, schemaURL, property string, result interface{}) error {
+	body, err := jsonEncode(map[string]interface{}{
+		"property_keys": []string{property},
+	})
+	if err != nil {
+		return err
+	}
+
+	response, err := neo4j.doRequest(ctx, "POST", schemaURL, body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal([]byte(response), result)
+}
+
+func validateSchemaItem(label, property string) error {
+	if label == "" {
+		return errors.New("Label is not given")
+	}
+
+	if property == "" {
+		return errors.New("Property is not given")
+	}
+
+	return nil
+}
+
+func schemaKey(label, property string) string {
+	return label + "\x00" + property
+}
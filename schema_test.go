@@ -0,0 +1,185 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func uniqueLabel(prefix string) string {
+	return fmt.Sprintf("%s%d", prefix, time.Now().UnixNano())
+}
+
+func TestSchemaIndexes(t *testing.T) {
+	neo4jConnection := Connect("")
+	label := uniqueLabel("SchemaIndexTest")
+
+	index, err := neo4jConnection.CreateSchemaIndex(label, "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if index.Label != label || len(index.PropertyKeys) != 1 || index.PropertyKeys[0] != "name" {
+		t.Error("Index is not valid", index)
+	}
+
+	indexes, err := neo4jConnection.GetSchemaIndexes(label)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(indexes) != 1 {
+		t.Error(len(indexes), "Index count is not valid")
+	}
+
+	if err := neo4jConnection.DropSchemaIndex(label, "name"); err != nil {
+		t.Error(err)
+	}
+
+	indexes, err = neo4jConnection.GetSchemaIndexes(label)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(indexes) != 0 {
+		t.Error(len(indexes), "Index is not dropped")
+	}
+
+	if _, err := neo4jConnection.CreateSchemaIndex("", "name"); err == nil {
+		t.Error("Creating index without label must fail")
+	}
+}
+
+func TestUniqueConstraints(t *testing.T) {
+	neo4jConnection := Connect("")
+	label := uniqueLabel("ConstraintTest")
+
+	constraint, err := neo4jConnection.CreateUniqueConstraint(label, "email")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if constraint.Type != ConstraintUniqueness || constraint.Label != label {
+		t.Error("Constraint is not valid", constraint)
+	}
+
+	constraints, err := neo4jConnection.GetConstraints(label)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(constraints) != 1 {
+		t.Error(len(constraints), "Constraint count is not valid")
+	}
+
+	_, err = neo4jConnection.Cypher(fmt.Sprintf("CREATE (n:%s {email: 'a@b.c'})", label), nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = neo4jConnection.Cypher(fmt.Sprintf("CREATE (n:%s {email: 'a@b.c'})", label), nil)
+	if err == nil {
+		t.Error("Violating the constraint must fail")
+	}
+
+	if err := neo4jConnection.DropUniqueConstraint(label, "email"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEnsureSchema(t *testing.T) {
+	neo4jConnection := Connect("")
+	label := uniqueLabel("EnsureSchemaTest")
+
+	// indexed property will be turned into a constraint
+	if _, err := neo4jConnection.CreateSchemaIndex(label, "email"); err != nil {
+		t.Fatal(err)
+	}
+
+	schema := &Schema{
+		Indexes: []SchemaIndex{
+			{Label: label, PropertyKeys: []string{"name", "email"}},
+		},
+		Constraints: []Constraint{
+			{Label: label, PropertyKeys: []string{"email"}},
+		},
+	}
+
+	if err := neo4jConnection.EnsureSchema(schema); err != nil {
+		t.Fatal(err)
+	}
+
+	// must be idempotent
+	if err := neo4jConnection.EnsureSchema(schema); err != nil {
+		t.Error(err)
+	}
+
+	constraints, err := neo4jConnection.GetConstraints(label)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(constraints) != 1 || constraints[0].PropertyKeys[0] != "email" {
+		t.Error("Constraints are not valid", constraints)
+	}
+
+	indexes, err := neo4jConnection.GetSchemaIndexes(label)
+	if err != nil {
+		t.Error(err)
+	}
+
+	found := false
+	for _, index := range indexes {
+		if index.PropertyKeys[0] == "name" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Error("Index is not created", indexes)
+	}
+
+	neo4jConnection.DropUniqueConstraint(label, "email")
+	neo4jConnection.DropSchemaIndex(label, "name")
+}
+
+func TestEnsureSchemaRestoresIndex(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /db/data/schema/index":
+			fmt.Fprint(w, `[{"label":"Person","property_keys":["email"]}]`)
+		case "GET /db/data/schema/constraint":
+			fmt.Fprint(w, `[]`)
+		case "DELETE /db/data/schema/index/Person/email":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /db/data/schema/constraint/Person/uniqueness":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errors":[{"code":"Neo.ClientError.Schema.ConstraintCreationFailed","message":"Duplicate values"}]}`)
+		case "POST /db/data/schema/index/Person":
+			fmt.Fprint(w, `{"label":"Person","property_keys":["email"]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	err := Connect(server.URL).EnsureSchema(&Schema{
+		Constraints: []Constraint{
+			{Label: "Person", PropertyKeys: []string{"email"}},
+		},
+	})
+
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) || nerr.StatusCode != http.StatusConflict {
+		t.Fatal("Constraint error is not returned", err)
+	}
+
+	if requests[len(requests)-1] != "POST /db/data/schema/index/Person" {
+		t.Error("Dropped index is not created again", requests)
+	}
+}
@@ -0,0 +1,135 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ServerInfo describes the server, it is returned by ServerInfo
+type ServerInfo struct {
+	Version string
+
+	// Edition is community or enterprise, it is empty if the server does
+	// not tell it. Only the servers from 2.2 on serve it
+	Edition string
+
+	// Endpoints are the urls advertised in the service root by their
+	// names, eg: "batch", "transaction"
+	Endpoints map[string]string
+
+	// Extensions are the server plugins and the urls of their methods
+	Extensions map[string]map[string]string
+}
+
+// Ping checks if the server is reachable and serves the REST API
+//
+// Example usage;
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//
+//	if err := neo4jConnection.Ping(ctx); err != nil {
+//		// not ready
+//	}
+func (neo4j *Neo4j) Ping(ctx context.Context) error {
+	_, err := neo4j.GetServiceRootContext(ctx)
+	return err
+}
+
+// ServerInfo returns the version, edition and endpoints of the server
+func (neo4j *Neo4j) ServerInfo() (*ServerInfo, error) {
+	return neo4j.ServerInfoContext(context.Background())
+}
+
+// ServerInfoContext is like ServerInfo but aborts the requests when ctx is
+// done
+func (neo4j *Neo4j) ServerInfoContext(ctx context.Context) (*ServerInfo, error) {
+	root, err := neo4j.GetServiceRootContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ServerInfo{
+		Version:    root.Version,
+		Extensions: root.Extensions,
+		Endpoints:  make(map[string]string),
+	}
+
+	endpoints := map[string]string{
+		"node":               root.Node,
+		"node_index":         root.NodeIndex,
+		"relationship_index": root.RelationshipIndex,
+		"relationship_types": root.RelationshipTypes,
+		"batch":              root.Batch,
+		"cypher":             root.Cypher,
+		"indexes":            root.Indexes,
+		"constraints":        root.Constraints,
+		"transaction":        root.Transaction,
+		"node_labels":        root.NodeLabels,
+		"extensions_info":    root.ExtensionsInfo,
+	}
+
+	for name, url := range endpoints {
+		if url != "" {
+			info.Endpoints[name] = url
+		}
+	}
+
+	version, err := neo4j.getServerVersion(ctx)
+	if err != nil {
+		// older servers do not serve the version endpoint and the management
+		// api can be restricted, the edition is left empty then
+		if IsNotFound(err) || isAuthError(err) {
+			return info, nil
+		}
+
+		return nil, err
+	}
+
+	info.Edition = version.Edition
+	if version.Version != "" {
+		info.Version = version.Version
+	}
+
+	return info, nil
+}
+
+// serverVersion is served by the management api
+type serverVersion struct {
+	Edition string `json:"edition"`
+	Version string `json:"version"`
+}
+
+// isAuthError checks if err is a Neo4jError caused by missing or
+// insufficient credentials
+func isAuthError(err error) bool {
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		return false
+	}
+
+	return nerr.StatusCode == http.StatusUnauthorized || nerr.StatusCode == http.StatusForbidden
+}
+
+func (neo4j *Neo4j) getServerVersion(ctx context.Context) (*serverVersion, error) {
+	response, err := neo4j.doRequest(ctx, "GET", neo4j.manageURL()+"/server/version", "")
+	if err != nil {
+		return nil, err
+	}
+
+	version := &serverVersion{}
+	if err := json.Unmarshal([]byte(response), version); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// manageURL returns the url of the management api, it is next to the data
+// api, eg: /db/manage
+func (neo4j *Neo4j) manageURL() string {
+	return strings.TrimSuffix(neo4j.BaseURL, "/data") + "/manage"
+}
@@ -0,0 +1,93 @@
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := Connect("").Ping(ctx); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPingUnreachableServer(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	if err := Connect(server.URL).Ping(context.Background()); err == nil {
+		t.Error("Ping should fail for unreachable servers")
+	}
+}
+
+func TestServerInfo(t *testing.T) {
+	info, err := Connect("").ServerInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Version == "" {
+		t.Error("Version is not set")
+	}
+
+	if info.Endpoints["batch"] == "" {
+		t.Error("Endpoints are not set", info.Endpoints)
+	}
+}
+
+func TestServerInfoWithEdition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/db/data/":
+			fmt.Fprintf(w, `{"node" : "http://%s/db/data/node", "transaction" : "", "neo4j_version" : "2.2.0"}`, r.Host)
+		case "/db/manage/server/version":
+			fmt.Fprint(w, `{"edition" : "enterprise", "version" : "2.2.0"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	info, err := Connect(server.URL).ServerInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Version != "2.2.0" || info.Edition != "enterprise" {
+		t.Error("Server info is not valid", info.Version, info.Edition)
+	}
+
+	if len(info.Endpoints) != 1 || info.Endpoints["node"] == "" {
+		t.Error("Endpoints are not valid", info.Endpoints)
+	}
+}
+
+func TestServerInfoWithRestrictedManagement(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/db/data/":
+				fmt.Fprintf(w, `{"node" : "http://%s/db/data/node", "neo4j_version" : "2.2.0"}`, r.Host)
+			default:
+				w.WriteHeader(status)
+			}
+		}))
+
+		info, err := Connect(server.URL).ServerInfo()
+		server.Close()
+		if err != nil {
+			t.Fatal(status, err)
+		}
+
+		if info.Version != "2.2.0" || info.Edition != "" {
+			t.Error("Server info is not valid", status, info.Version, info.Edition)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ServiceRoot is the document Neo4j serves at /db/data/, it advertises the
+// urls of the endpoints. Endpoints which are not supported by the server
+// are empty, eg: Transaction before 2.0
+type ServiceRoot struct {
+	Node              string                       `json:"node"`
+	NodeIndex         string                       `json:"node_index"`
+	RelationshipIndex string                       `json:"relationship_index"`
+	RelationshipTypes string                       `json:"relationship_types"`
+	Batch             string                       `json:"batch"`
+	Cypher            string                       `json:"cypher"`
+	Indexes           string                       `json:"indexes"`
+	Constraints       string                       `json:"constraints"`
+	Transaction       string                       `json:"transaction"`
+	NodeLabels        string                       `json:"node_labels"`
+	ExtensionsInfo    string                       `json:"extensions_info"`
+	Extensions        map[string]map[string]string `json:"extensions"`
+	Version           string                       `json:"neo4j_version"`
+}
+
+// Discover gets the service root and sets the urls of the connection to the
+// ones advertised by the server, so the connection works behind proxies
+// which serve Neo4j under a different path. Urls of the endpoints which are
+// not advertised are not changed.
+//
+// Example usage;
+//
+//	neo4jConnection := Connect("http://127.0.0.1:7474")
+//	if err := neo4jConnection.Discover(); err != nil {
+//		return err
+//	}
+//
+//	if !neo4jConnection.VersionAtLeast(2, 0) {
+//		return errors.New("labels are not supported")
+//	}
+func (neo4j *Neo4j) Discover() error {
+	return neo4j.DiscoverContext(context.Background())
+}
+
+// DiscoverContext is like Discover but aborts the request when ctx is done
+func (neo4j *Neo4j) DiscoverContext(ctx context.Context) error {
+	root, err := neo4j.GetServiceRootContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if root.Node != "" {
+		neo4j.NodeURL = root.Node
+		neo4j.BaseURL = strings.TrimSuffix(root.Node, "/node")
+		neo4j.RelationshipURL = neo4j.BaseURL + "/relationship"
+	}
+
+	setURL(&neo4j.IndexNodeURL, root.NodeIndex)
+	setURL(&neo4j.IndexRelationshipURL, root.RelationshipIndex)
+	setURL(&neo4j.RelationshipTypesURL, root.RelationshipTypes)
+	setURL(&neo4j.BatchURL, root.Batch)
+	setURL(&neo4j.CypherURL, root.Cypher)
+	setURL(&neo4j.SchemaIndexURL, root.Indexes)
+	setURL(&neo4j.ConstraintURL, root.Constraints)
+	setURL(&neo4j.TransactionURL, root.Transaction)
+	setURL(&neo4j.NodeLabelsURL, root.NodeLabels)
+	setURL(&neo4j.ExtensionsInfoURL, root.ExtensionsInfo)
+
+	neo4j.Extensions = root.Extensions
+	neo4j.Version = root.Version
+
+	return nil
+}
+
+// GetServiceRoot gets the service root of the server
+func (neo4j *Neo4j) GetServiceRoot() (*ServiceRoot, error) {
+	return neo4j.GetServiceRootContext(context.Background())
+}
+
+// GetServiceRootContext is like GetServiceRoot but aborts the request when
+// ctx is done
+func (neo4j *Neo4j) GetServiceRootContext(ctx context.Context) (*ServiceRoot, error) {
+	response, err := neo4j.doRequest(ctx, "GET", neo4j.BaseURL+"/", "")
+	if err != nil {
+		return nil, err
+	}
+
+	root := &ServiceRoot{}
+	if err := json.Unmarshal([]byte(response), root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// VersionAtLeast checks if the version of the server is the given version or
+// a later one, it returns false if the version is not known, see Discover
+func (neo4j *Neo4j) VersionAtLeast(major, minor int) bool {
+	serverMajor, serverMinor, ok := parseVersion(neo4j.Version)
+	if !ok {
+		return false
+	}
+
+	if serverMajor != major {
+		return serverMajor > major
+	}
+
+	return serverMinor >= minor
+}
+
+// parseVersion returns the major and minor numbers of versions like 2.1.5
+// or 2.2.0-M02
+func parseVersion(version string) (major, minor int, ok bool) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	minor, err = strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
+
+func setURL(dst *string, advertised string) {
+	if advertised != "" {
+		*dst = advertised
+	}
+}
@@ -0,0 +1,97 @@
+package neo4j
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiscover(t *testing.T) {
+	neo4jConnection := Connect("")
+	if err := neo4jConnection.Discover(); err != nil {
+		t.Fatal(err)
+	}
+
+	if neo4jConnection.Version == "" {
+		t.Error("Version is not set")
+	}
+
+	if !neo4jConnection.VersionAtLeast(1, 0) {
+		t.Error("Version is not valid", neo4jConnection.Version)
+	}
+}
+
+func TestDiscoverBehindProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/proxy/db/data/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		base := "http://" + r.Host + "/proxy/db/data"
+		fmt.Fprintf(w, `{
+			"extensions" : {"GremlinPlugin" : {"execute_script" : "%[1]s/ext/GremlinPlugin/graphdb/execute_script"}},
+			"node" : "%[1]s/node",
+			"node_index" : "%[1]s/index/node",
+			"relationship_index" : "%[1]s/index/relationship",
+			"extensions_info" : "%[1]s/ext",
+			"relationship_types" : "%[1]s/relationship/types",
+			"batch" : "%[1]s/batch",
+			"cypher" : "%[1]s/cypher",
+			"indexes" : "%[1]s/schema/index",
+			"constraints" : "%[1]s/schema/constraint",
+			"node_labels" : "%[1]s/labels",
+			"neo4j_version" : "2.1.5"
+		}`, base)
+	}))
+	defer server.Close()
+
+	neo4jConnection, err := ConnectWithOptions(server.URL+"/proxy", WithDiscovery())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := server.URL + "/proxy/db/data"
+	if neo4jConnection.BaseURL != base || neo4jConnection.NodeURL != base+"/node" {
+		t.Error("Urls are not discovered", neo4jConnection.BaseURL, neo4jConnection.NodeURL)
+	}
+
+	if neo4jConnection.RelationshipURL != base+"/relationship" {
+		t.Error("Relationship url is not valid", neo4jConnection.RelationshipURL)
+	}
+
+	if neo4jConnection.Extensions["GremlinPlugin"]["execute_script"] == "" {
+		t.Error("Extensions are not set", neo4jConnection.Extensions)
+	}
+
+	// not advertised urls are kept
+	if neo4jConnection.TransactionURL != base+"/transaction" {
+		t.Error("Transaction url is not valid", neo4jConnection.TransactionURL)
+	}
+
+	if !neo4jConnection.VersionAtLeast(2, 1) || neo4jConnection.VersionAtLeast(2, 2) {
+		t.Error("Version is not valid", neo4jConnection.Version)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		version      string
+		major, minor int
+		ok           bool
+	}{
+		{"2.1.5", 2, 1, true},
+		{"2.2.0-M02", 2, 2, true},
+		{"3.0-RC1", 3, 0, true},
+		{"", 0, 0, false},
+		{"unknown", 0, 0, false},
+	}
+
+	for _, test := range tests {
+		major, minor, ok := parseVersion(test.version)
+		if major != test.major || minor != test.minor || ok != test.ok {
+			t.Error("Version is not parsed", test.version, major, minor, ok)
+		}
+	}
+}
@@ -0,0 +1,400 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Errors returned by Transaction methods
+var (
+	// ErrTransactionClosed is returned when the transaction is already
+	// committed or rolled back
+	ErrTransactionClosed = errors.New("Transaction is already committed or rolled back")
+
+	// ErrTransactionExpired is returned when the server has dropped the
+	// transaction because it was idle for too long
+	ErrTransactionExpired = errors.New("Transaction is expired")
+)
+
+// Transaction is an open transaction on the transactional Cypher endpoint.
+// Changes made by the statements are only visible to other clients after
+// Commit. If a statement fails, Neo4j rolls the transaction back and every
+// following call returns ErrTransactionClosed.
+//
+// For more information please check : http://neo4j.com/docs/stable/rest-api-transactional.html
+//
+// Transaction is safe for concurrent use, requests are sent one at a time
+// because Neo4j does not accept concurrent requests for a transaction.
+type Transaction struct {
+	Neo4j     *Neo4j
+	URL       string
+	CommitURL string
+	// Expires is the time the server will roll the transaction back
+	// unless a new request is sent, it is renewed on every request. It is
+	// in server time, the client relies on the server to report expiry
+	Expires time.Time
+
+	mu   sync.Mutex
+	err  error
+	done chan struct{}
+
+	// keepAliveErr is the error of the failed background keep alive
+	keepAliveErr error
+}
+
+type transactionStatement struct {
+	Statement          string                 `json:"statement"`
+	Parameters         map[string]interface{} `json:"parameters,omitempty"`
+	ResultDataContents []string               `json:"resultDataContents,omitempty"`
+}
+
+type transactionRequest struct {
+	Statements []*transactionStatement `json:"statements"`
+}
+
+// transactionResponse is the common response of transactional endpoint
+type transactionResponse struct {
+	Commit  string `json:"commit"`
+	Results []struct {
+		Columns []string `json:"columns"`
+		Data    []struct {
+			Rest []interface{} `json:"rest"`
+		} `json:"data"`
+	} `json:"results"`
+	Transaction struct {
+		Expires string `json:"expires"`
+	} `json:"transaction"`
+	Errors []struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+
+	statusCode int
+	status     string
+}
+
+// error returns the first error reported in the response body as Neo4jError
+func (resp *transactionResponse) error() error {
+	if len(resp.Errors) == 0 {
+		return nil
+	}
+
+	return &Neo4jError{
+		StatusCode: resp.statusCode,
+		Status:     resp.status,
+		Code:       resp.Errors[0].Code,
+		Message:    resp.Errors[0].Message,
+		Index:      -1,
+	}
+}
+
+// Begin opens a new transaction
+func (neo4j *Neo4j) Begin() (*Transaction, error) {
+	return neo4j.BeginContext(context.Background())
+}
+
+// BeginContext is like Begin but aborts the request when ctx is done
+func (neo4j *Neo4j) BeginContext(ctx context.Context) (*Transaction, error) {
+	tx := &Transaction{
+		Neo4j: neo4j,
+		done:  make(chan struct{}),
+	}
+
+	resp, header, err := tx.send(ctx, "POST", neo4j.TransactionURL, &transactionRequest{})
+	if err == nil {
+		err = resp.error()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	tx.URL = header.Get("Location")
+	if tx.URL == "" {
+		return nil, errors.New("Transaction location is not returned")
+	}
+
+	tx.update(resp)
+
+	return tx, nil
+}
+
+// Run executes the statement with given parameters in the transaction,
+// nodes and relationships in the rows are returned as *Node and *Relationship
+func (tx *Transaction) Run(statement string, params map[string]interface{}) (*Result, error) {
+	return tx.RunContext(context.Background(), statement, params)
+}
+
+// RunContext is like Run but aborts the request when ctx is done
+func (tx *Transaction) RunContext(ctx context.Context, statement string, params map[string]interface{}) (*Result, error) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	request := &transactionRequest{
+		Statements: []*transactionStatement{
+			{
+				Statement:  statement,
+				Parameters: params,
+				// REST format lets us map nodes and relationships
+				ResultDataContents: []string{"REST"},
+			},
+		},
+	}
+
+	resp, err := tx.do(ctx, "POST", tx.URL, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Results) == 0 {
+		return &Result{}, nil
+	}
+
+	rows := make([][]interface{}, len(resp.Results[0].Data))
+	for i, data := range resp.Results[0].Data {
+		rows[i] = data.Rest
+	}
+
+	return newResult(tx.Neo4j, resp.Results[0].Columns, rows), nil
+}
+
+// Commit commits the transaction
+func (tx *Transaction) Commit() error {
+	return tx.CommitContext(context.Background())
+}
+
+// CommitContext is like Commit but aborts the request when ctx is done
+func (tx *Transaction) CommitContext(ctx context.Context) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if _, err := tx.do(ctx, "POST", tx.CommitURL, &transactionRequest{}); err != nil {
+		return err
+	}
+
+	tx.close(ErrTransactionClosed)
+
+	return nil
+}
+
+// Rollback rolls the transaction back
+func (tx *Transaction) Rollback() error {
+	return tx.RollbackContext(context.Background())
+}
+
+// RollbackContext is like Rollback but aborts the request when ctx is done
+func (tx *Transaction) RollbackContext(ctx context.Context) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	// the server may still hold the transaction after a failed keep alive
+	if tx.err != nil && tx.err == tx.keepAliveErr {
+		resp, _, err := tx.send(ctx, "DELETE", tx.URL, nil)
+		if err != nil {
+			return err
+		}
+
+		return resp.error()
+	}
+
+	if _, err := tx.do(ctx, "DELETE", tx.URL, nil); err != nil {
+		return err
+	}
+
+	tx.close(ErrTransactionClosed)
+
+	return nil
+}
+
+// KeepAlive resets the expiry time of the transaction without running
+// any statement
+func (tx *Transaction) KeepAlive() error {
+	return tx.KeepAliveContext(context.Background())
+}
+
+// KeepAliveContext is like KeepAlive but aborts the request when ctx is done
+func (tx *Transaction) KeepAliveContext(ctx context.Context) error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	_, err := tx.do(ctx, "POST", tx.URL, &transactionRequest{})
+
+	return err
+}
+
+// AutoKeepAlive calls KeepAlive in the background with the given interval
+// until the transaction is committed, rolled back or expired.
+// interval should be shorter than the transaction timeout of the server,
+// which is 60 seconds by default. If a keep alive fails the transaction is
+// closed and the error is returned by the next call, Rollback still tries
+// to roll the transaction back on the server. A keep alive which takes
+// longer than interval fails.
+func (tx *Transaction) AutoKeepAlive(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		// pending keep alive is aborted when the transaction is closed
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-tx.done:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		for {
+			select {
+			case <-tx.done:
+				return
+			case <-ticker.C:
+				if err := tx.keepAlive(ctx, interval); err != nil {
+					tx.keepAliveFailed(err)
+					return
+				}
+			}
+		}
+	}()
+}
+
+// keepAlive sends a keep alive which is aborted after timeout
+func (tx *Transaction) keepAlive(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return tx.KeepAliveContext(ctx)
+}
+
+// do sends the request for an open transaction and updates the state of
+// the transaction with the response, callers must hold tx.mu
+func (tx *Transaction) do(ctx context.Context, method, url string, request *transactionRequest) (*transactionResponse, error) {
+	if tx.err != nil {
+		return nil, tx.err
+	}
+
+	// expiry is not checked with Expires, the clocks of the client and the
+	// server can differ, the server reports an unknown transaction instead
+
+	resp, _, err := tx.send(ctx, method, url, request)
+	if err == nil {
+		err = resp.error()
+	}
+
+	if isUnknownTransaction(err) {
+		tx.close(ErrTransactionExpired)
+		return nil, tx.err
+	}
+
+	if resp != nil && err != nil {
+		// the server has already rolled the transaction back
+		tx.close(ErrTransactionClosed)
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	tx.update(resp)
+
+	return resp, nil
+}
+
+// send makes the request and decodes the response, errors reported in the
+// response body are left to the caller
+func (tx *Transaction) send(ctx context.Context, method, url string, request *transactionRequest) (*transactionResponse, http.Header, error) {
+	data := ""
+	if request != nil {
+		if request.Statements == nil {
+			request.Statements = make([]*transactionStatement, 0)
+		}
+
+		encoded, err := jsonEncode(request)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = encoded
+	}
+
+	req, err := tx.Neo4j.newRequest(ctx, method, url, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := tx.Neo4j.Client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	// all requests return 200 or 201 even if the statements fail
+	if res.StatusCode != 200 && res.StatusCode != 201 {
+		return nil, nil, newError(res)
+	}
+
+	resp := &transactionResponse{}
+	if err := json.NewDecoder(res.Body).Decode(resp); err != nil {
+		return nil, nil, err
+	}
+
+	resp.statusCode, resp.status = res.StatusCode, res.Status
+
+	return resp, res.Header, nil
+}
+
+// update renews the transaction state from the response
+func (tx *Transaction) update(resp *transactionResponse) {
+	if resp.Commit != "" {
+		tx.CommitURL = resp.Commit
+	}
+
+	if resp.Transaction.Expires != "" {
+		expires, err := time.Parse(time.RFC1123Z, resp.Transaction.Expires)
+		if err == nil {
+			tx.Expires = expires
+		}
+	}
+}
+
+// keepAliveFailed records the error of the background keep alive, so it is
+// reported by the next call
+func (tx *Transaction) keepAliveFailed(err error) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	// the transaction may be already closed by the failing request
+	if tx.err == nil {
+		tx.keepAliveErr = err
+		tx.close(err)
+	}
+}
+
+// close marks the transaction as finished, all following calls return err
+func (tx *Transaction) close(err error) {
+	if tx.err != nil {
+		return
+	}
+
+	tx.err = err
+	close(tx.done)
+}
+
+// isUnknownTransaction checks if the server does not know the transaction,
+// which happens when the transaction is timed out
+func isUnknownTransaction(err error) bool {
+	var nerr *Neo4jError
+	if !errors.As(err, &nerr) {
+		return false
+	}
+
+	return nerr.StatusCode == http.StatusNotFound ||
+		strings.HasPrefix(nerr.Code, "Neo.ClientError.Transaction.UnknownId") ||
+		strings.HasPrefix(nerr.Code, "Neo.ClientError.Transaction.TransactionNotFound")
+}
@@ -0,0 +1,247 @@
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTransactionCommit(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	tx, err := neo4jConnection.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tx.URL == "" || tx.CommitURL == "" {
+		t.Error("Transaction urls are not set")
+	}
+
+	if tx.Expires.IsZero() {
+		t.Error("Transaction expiry is not set")
+	}
+
+	res, err := tx.Run("CREATE (n {name: {name}}) RETURN id(n) AS id, n.name AS name", map[string]interface{}{
+		"name": "transactionCommit",
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(res.Columns) != 2 || res.Columns[0] != "id" || res.Columns[1] != "name" {
+		t.Error("Columns are not valid", res.Columns)
+	}
+
+	if res.Len() != 1 {
+		t.Fatal(res.Len(), "Row count is not valid")
+	}
+
+	if res.Rows[0][res.ColumnIndex("name")] != "transactionCommit" {
+		t.Error("Row is not valid", res.Rows[0])
+	}
+
+	if err := tx.KeepAlive(); err != nil {
+		t.Error(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := tx.Run("RETURN 1", nil); err != ErrTransactionClosed {
+		t.Error("Running statement on committed transaction must fail", err)
+	}
+}
+
+func TestTransactionRollback(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	tx, err := neo4jConnection.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := tx.Run("CREATE (n {name: 'transactionRollback'}) RETURN id(n)", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := res.Rows[0][0]
+
+	if err := tx.Rollback(); err != nil {
+		t.Error(err)
+	}
+
+	if err := tx.Commit(); err != ErrTransactionClosed {
+		t.Error("Committing rolled back transaction must fail", err)
+	}
+
+	check, err := neo4jConnection.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer check.Rollback()
+
+	res, err = check.Run("START n=node(*) WHERE id(n) = {id} RETURN n", map[string]interface{}{"id": id})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if res.Len() != 0 {
+		t.Error("Rolled back node exists")
+	}
+}
+
+func TestTransactionWithInvalidStatement(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	tx, err := neo4jConnection.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tx.Run("NOT A CYPHER STATEMENT", nil)
+	if _, ok := err.(*Neo4jError); !ok {
+		t.Error("Error is not valid", err)
+	}
+
+	if _, err := tx.Run("RETURN 1", nil); err != ErrTransactionClosed {
+		t.Error("Failed transaction must be closed", err)
+	}
+}
+
+// transactionServer serves a transaction which expired on the server clock
+// already, requests to the open transaction are answered by handle
+func transactionServer(handle func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/db/data/transaction" {
+			w.Header().Set("Location", "http://"+r.Host+"/db/data/transaction/1")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"commit" : "http://%s/db/data/transaction/1/commit", "results" : [], "transaction" : {"expires" : "%s"}, "errors" : []}`,
+				r.Host, time.Now().Add(-time.Hour).Format(time.RFC1123Z))
+			return
+		}
+
+		handle(w, r)
+	}))
+}
+
+func TestTransactionExpiry(t *testing.T) {
+	requests := 0
+	server := transactionServer(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			fmt.Fprint(w, `{"results" : [{"columns" : ["1"], "data" : [{"rest" : [1]}]}], "errors" : []}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"results" : [], "errors" : [{"code" : "Neo.ClientError.Transaction.UnknownId", "message" : "Unrecognized transaction id"}]}`)
+	})
+	defer server.Close()
+
+	tx, err := Connect(server.URL).Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// expiry time of the server is not compared with the local clock
+	if _, err := tx.Run("RETURN 1", nil); err != nil {
+		t.Error("Transaction should be used until the server expires it", err)
+	}
+
+	if _, err := tx.Run("RETURN 1", nil); err != ErrTransactionExpired {
+		t.Error("Expired transaction must fail", err)
+	}
+
+	if err := tx.Rollback(); err != ErrTransactionExpired {
+		t.Error("Expired transaction must fail", err)
+	}
+
+	if requests != 2 {
+		t.Error("Expired transaction should not be sent", requests)
+	}
+}
+
+func TestTransactionAutoKeepAliveError(t *testing.T) {
+	var rolledBack int32
+	server := transactionServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			atomic.StoreInt32(&rolledBack, 1)
+			fmt.Fprint(w, `{"results" : [], "errors" : []}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	tx, err := Connect(server.URL).Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx.AutoKeepAlive(time.Millisecond)
+
+	select {
+	case <-tx.done:
+	case <-time.After(time.Second):
+		t.Fatal("Failed keep alive does not close the transaction")
+	}
+
+	var nerr *Neo4jError
+	if _, err := tx.Run("RETURN 1", nil); !errors.As(err, &nerr) || nerr.StatusCode != http.StatusBadGateway {
+		t.Error("Keep alive error is not reported", err)
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Error(err)
+	}
+
+	if atomic.LoadInt32(&rolledBack) != 1 {
+		t.Error("Transaction is not rolled back on the server")
+	}
+}
+
+func TestTransactionAutoKeepAliveTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := transactionServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			fmt.Fprint(w, `{"results" : [], "errors" : [{"code" : "Neo.DatabaseError.Transaction.CouldNotRollback", "message" : "Rollback failed"}]}`)
+			return
+		}
+
+		// keep alive does not complete until the test ends
+		<-release
+	})
+	defer server.Close()
+	defer close(release)
+
+	tx, err := Connect(server.URL).Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx.AutoKeepAlive(10 * time.Millisecond)
+
+	select {
+	case <-tx.done:
+	case <-time.After(time.Second):
+		t.Fatal("Hanging keep alive is not aborted")
+	}
+
+	if _, err := tx.Run("RETURN 1", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("Keep alive error is not reported", err)
+	}
+
+	var nerr *Neo4jError
+	if err := tx.Rollback(); !errors.As(err, &nerr) || nerr.Code != "Neo.DatabaseError.Transaction.CouldNotRollback" {
+		t.Error("Rollback error is not reported", err)
+	}
+}
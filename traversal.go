@@ -0,0 +1,365 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Relationship directions
+var (
+	DirectionAll = "all"
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
+
+// Traversal orders
+var (
+	BreadthFirst = "breadth_first"
+	DepthFirst   = "depth_first"
+)
+
+// Traversal uniqueness rules, they define which nodes or relationships can
+// be visited more than once
+var (
+	UniqueNone               = "none"
+	UniqueNodeGlobal         = "node_global"
+	UniqueRelationshipGlobal = "relationship_global"
+	UniqueNodePath           = "node_path"
+	UniqueRelationshipPath   = "relationship_path"
+)
+
+// Traversal return types
+var (
+	TraverseNode         = "node"
+	TraverseRelationship = "relationship"
+	TraversePath         = "path"
+	// TraverseFullPath returns paths with full nodes and relationships
+	TraverseFullPath = "fullpath"
+)
+
+// Builtin evaluators
+var (
+	// PruneNone does not prune the traversal
+	PruneNone = &Evaluator{Language: "builtin", Name: "none"}
+
+	// ReturnAll returns all visited entities
+	ReturnAll = &Evaluator{Language: "builtin", Name: "all"}
+
+	// ReturnAllButStartNode returns all visited entities except the start
+	// node
+	ReturnAllButStartNode = &Evaluator{Language: "builtin", Name: "all_but_start_node"}
+)
+
+// Evaluator decides where to stop a traversal or what to return from it,
+// either Name of a builtin evaluator or Body of a javascript one should be
+// set
+type Evaluator struct {
+	Language string `json:"language"`
+	Name     string `json:"name,omitempty"`
+	Body     string `json:"body,omitempty"`
+}
+
+// JavaScriptEvaluator creates an evaluator with the given javascript body,
+// the current path is available as position, eg:
+//
+//	position.endNode().getProperty('name').toLowerCase().contains('t')
+func JavaScriptEvaluator(body string) *Evaluator {
+	return &Evaluator{Language: "javascript", Body: body}
+}
+
+// TraversalRelationship is a relationship type and direction which can be
+// followed by a traversal
+type TraversalRelationship struct {
+	Type      string `json:"type"`
+	Direction string `json:"direction,omitempty"`
+}
+
+// TraversalDescription describes a traversal, zero values are left to the
+// server defaults; breadth first order, node global uniqueness, all
+// relationships, max depth 1 and returning all entities.
+//
+// Example usage;
+//
+//	desc := NewTraversal().
+//		DepthFirst().
+//		Relationship("KNOWS", DirectionOut).
+//		Depth(3).
+//		Filter(ReturnAllButStartNode)
+//
+//	nodes, err := neo4jConnection.TraverseNodes(node, desc)
+type TraversalDescription struct {
+	Order         string
+	Uniqueness    string
+	Relationships []TraversalRelationship
+	// MaxDepth is ignored by the server if Prune is set
+	MaxDepth     int
+	Prune        *Evaluator
+	ReturnFilter *Evaluator
+}
+
+// NewTraversal creates an empty traversal description
+func NewTraversal() *TraversalDescription {
+	return &TraversalDescription{}
+}
+
+// BreadthFirst sets the order of the traversal to breadth first
+func (desc *TraversalDescription) BreadthFirst() *TraversalDescription {
+	desc.Order = BreadthFirst
+	return desc
+}
+
+// DepthFirst sets the order of the traversal to depth first
+func (desc *TraversalDescription) DepthFirst() *TraversalDescription {
+	desc.Order = DepthFirst
+	return desc
+}
+
+// Unique sets the uniqueness rule of the traversal, eg: UniqueNodePath
+func (desc *TraversalDescription) Unique(uniqueness string) *TraversalDescription {
+	desc.Uniqueness = uniqueness
+	return desc
+}
+
+// Relationship adds a relationship type to follow in the given direction
+func (desc *TraversalDescription) Relationship(relationshipType, direction string) *TraversalDescription {
+	desc.Relationships = append(desc.Relationships, TraversalRelationship{
+		Type:      relationshipType,
+		Direction: direction,
+	})
+	return desc
+}
+
+// Depth sets the max depth of the traversal
+func (desc *TraversalDescription) Depth(max int) *TraversalDescription {
+	desc.MaxDepth = max
+	return desc
+}
+
+// PruneWith sets the evaluator which stops the traversal
+func (desc *TraversalDescription) PruneWith(evaluator *Evaluator) *TraversalDescription {
+	desc.Prune = evaluator
+	return desc
+}
+
+// Filter sets the evaluator which selects the returned entities
+func (desc *TraversalDescription) Filter(evaluator *Evaluator) *TraversalDescription {
+	desc.ReturnFilter = evaluator
+	return desc
+}
+
+// TraverseNodes traverses the graph from the start node and returns the
+// visited nodes
+func (neo4j *Neo4j) TraverseNodes(start *Node, desc *TraversalDescription) ([]Node, error) {
+	return neo4j.TraverseNodesContext(context.Background(), start, desc)
+}
+
+// TraverseNodesContext is like TraverseNodes but aborts the request when ctx
+// is done
+func (neo4j *Neo4j) TraverseNodesContext(ctx context.Context, start *Node, desc *TraversalDescription) ([]Node, error) {
+	result, err := neo4j.traverse(ctx, start, desc, TraverseNode)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]Node), nil
+}
+
+// TraverseRelationships traverses the graph from the start node and returns
+// the visited relationships
+func (neo4j *Neo4j) TraverseRelationships(start *Node, desc *TraversalDescription) ([]Relationship, error) {
+	return neo4j.TraverseRelationshipsContext(context.Background(), start, desc)
+}
+
+// TraverseRelationshipsContext is like TraverseRelationships but aborts the
+// request when ctx is done
+func (neo4j *Neo4j) TraverseRelationshipsContext(ctx context.Context, start *Node, desc *TraversalDescription) ([]Relationship, error) {
+	result, err := neo4j.traverse(ctx, start, desc, TraverseRelationship)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]Relationship), nil
+}
+
+// TraversePaths traverses the graph from the start node and returns the
+// visited paths, only the ids of their nodes and relationships are set
+// unless full is true
+func (neo4j *Neo4j) TraversePaths(start *Node, desc *TraversalDescription, full bool) ([]Path, error) {
+	return neo4j.TraversePathsContext(context.Background(), start, desc, full)
+}
+
+// TraversePathsContext is like TraversePaths but aborts the request when ctx
+// is done
+func (neo4j *Neo4j) TraversePathsContext(ctx context.Context, start *Node, desc *TraversalDescription, full bool) ([]Path, error) {
+	returnType := TraversePath
+	if full {
+		returnType = TraverseFullPath
+	}
+
+	result, err := neo4j.traverse(ctx, start, desc, returnType)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]Path), nil
+}
+
+// traverse sends the traversal and decodes its result for the return type
+func (neo4j *Neo4j) traverse(ctx context.Context, start *Node, desc *TraversalDescription, returnType string) (interface{}, error) {
+	traverseURL, encodedBody, err := neo4j.traverseRequest(start, desc, returnType, false)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := neo4j.doRequest(ctx, "POST", traverseURL, encodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0)
+	if err := json.Unmarshal([]byte(response), &items); err != nil {
+		return nil, err
+	}
+
+	return decodeTraversal(neo4j, items, returnType)
+}
+
+// traverseRequest returns the url and body of the traversal request, the
+// urls advertised by the node are used if it is read from the server
+func (neo4j *Neo4j) traverseRequest(start *Node, desc *TraversalDescription, returnType string, paged bool) (string, string, error) {
+	if start == nil || start.ID == "" {
+		return "", "", errors.New("Start node Id not valid")
+	}
+
+	if !isTraversalReturnType(returnType) {
+		return "", "", fmt.Errorf("Return type %s is not valid", returnType)
+	}
+
+	body, err := desc.body()
+	if err != nil {
+		return "", "", err
+	}
+
+	encodedBody, err := jsonEncode(body)
+	if err != nil {
+		return "", "", err
+	}
+
+	path, template := "traverse", ""
+	if paged {
+		path = "paged/traverse"
+	}
+
+	if start.Payload != nil {
+		template = start.Payload.Traverse
+		if paged {
+			// query parameters are added by the caller
+			template = strings.Replace(start.Payload.PagedTraverse, "{?pageSize,leaseTime}", "", 1)
+		}
+	}
+
+	traverseURL := fmt.Sprintf("%s/%s/%s/%s", neo4j.NodeURL, start.ID, path, returnType)
+	if template != "" {
+		traverseURL = strings.Replace(template, "{returnType}", returnType, 1)
+	}
+
+	return traverseURL, encodedBody, nil
+}
+
+// body returns the request body of the description
+func (desc *TraversalDescription) body() (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	if desc == nil {
+		return body, nil
+	}
+
+	if desc.Order != "" {
+		if desc.Order != BreadthFirst && desc.Order != DepthFirst {
+			return nil, fmt.Errorf("Order %s is not valid", desc.Order)
+		}
+		body["order"] = desc.Order
+	}
+
+	if desc.Uniqueness != "" {
+		body["uniqueness"] = desc.Uniqueness
+	}
+
+	if len(desc.Relationships) > 0 {
+		for _, relationship := range desc.Relationships {
+			if err := validateDirection(relationship.Direction); err != nil {
+				return nil, err
+			}
+		}
+		body["relationships"] = desc.Relationships
+	}
+
+	if desc.MaxDepth > 0 {
+		body["max_depth"] = desc.MaxDepth
+	}
+
+	if desc.Prune != nil {
+		body["prune_evaluator"] = desc.Prune
+	}
+
+	if desc.ReturnFilter != nil {
+		body["return_filter"] = desc.ReturnFilter
+	}
+
+	return body, nil
+}
+
+// decodeTraversal converts the returned entities for the return type, the
+// result is []Node, []Relationship or []Path
+func decodeTraversal(neo4j *Neo4j, items []interface{}, returnType string) (interface{}, error) {
+	switch returnType {
+	case TraverseNode:
+		nodes := make([]Node, len(items))
+		for i, item := range items {
+			if _, err := nodes[i].mapBatchResponse(neo4j, item); err != nil {
+				return nil, err
+			}
+		}
+		return nodes, nil
+	case TraverseRelationship:
+		relationships := make([]Relationship, len(items))
+		for i, item := range items {
+			if _, err := relationships[i].mapBatchResponse(neo4j, item); err != nil {
+				return nil, err
+			}
+		}
+		return relationships, nil
+	}
+
+	paths := make([]Path, len(items))
+	for i, item := range items {
+		path, err := decodePath(neo4j, item)
+		if err != nil {
+			return nil, err
+		}
+		paths[i] = *path
+	}
+
+	return paths, nil
+}
+
+func isTraversalReturnType(returnType string) bool {
+	switch returnType {
+	case TraverseNode, TraverseRelationship, TraversePath, TraverseFullPath:
+		return true
+	}
+
+	return false
+}
+
+// validateDirection checks the direction of a relationship, empty means all
+func validateDirection(direction string) error {
+	switch direction {
+	case "", DirectionAll, DirectionIn, DirectionOut:
+		return nil
+	}
+
+	return fmt.Errorf("Direction %s is not valid", direction)
+}
@@ -0,0 +1,142 @@
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTraverse(t *testing.T) {
+	neo4jConnection := Connect("")
+	batch := neo4jConnection.NewBatch()
+
+	first := batch.CreateRef(createNewNode())
+	second := batch.CreateRef(createNewNode())
+	third := batch.CreateRef(createNewNode())
+	batch.Create(&Relationship{Type: "TRAVERSE_TEST", StartNodeID: first.ID(), EndNodeID: second.ID()})
+	batch.Create(&Relationship{Type: "TRAVERSE_TEST", StartNodeID: second.ID(), EndNodeID: third.ID()})
+
+	if _, err := batch.Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	desc := NewTraversal().
+		DepthFirst().
+		Relationship("TRAVERSE_TEST", DirectionOut).
+		Depth(2).
+		Filter(ReturnAllButStartNode)
+
+	nodes, err := neo4jConnection.TraverseNodes(first.Node(), desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(nodes) != 2 {
+		t.Error("Traversed nodes are not valid", len(nodes))
+	}
+
+	relationships, err := neo4jConnection.TraverseRelationships(first.Node(), desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(relationships) != 2 {
+		t.Error("Traversed relationships are not valid", len(relationships))
+	}
+
+	paths, err := neo4jConnection.TraversePaths(first.Node(), desc, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatal("Traversed paths are not valid", len(paths))
+	}
+
+	for _, path := range paths {
+		if path.Start.ID != first.ID() || len(path.Nodes) != path.Length+1 {
+			t.Error("Path is not valid", path.Start.ID, path.Length)
+		}
+
+		if path.Nodes[0].Data == nil {
+			t.Error("Full path nodes are not set")
+		}
+	}
+}
+
+func TestTraverseRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/db/data/node/1/traverse/path" {
+			t.Error("Traverse url is not valid", r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		request := make(map[string]interface{})
+		json.Unmarshal(body, &request)
+
+		if request["order"] != BreadthFirst || request["uniqueness"] != UniqueNodePath || request["max_depth"] != float64(2) {
+			t.Error("Traversal description is not valid", string(body))
+		}
+
+		prune := request["prune_evaluator"].(map[string]interface{})
+		if prune["language"] != "javascript" || prune["body"] != "position.length() > 2" {
+			t.Error("Prune evaluator is not valid", prune)
+		}
+
+		base := "http://" + r.Host + "/db/data"
+		fmt.Fprintf(w, `[{
+			"start" : "%[1]s/node/1",
+			"nodes" : ["%[1]s/node/1", "%[1]s/node/2"],
+			"relationships" : ["%[1]s/relationship/7"],
+			"end" : "%[1]s/node/2",
+			"length" : 1
+		}]`, base)
+	}))
+	defer server.Close()
+
+	desc := NewTraversal().
+		BreadthFirst().
+		Unique(UniqueNodePath).
+		Relationship("KNOWS", DirectionAll).
+		Depth(2).
+		PruneWith(JavaScriptEvaluator("position.length() > 2"))
+
+	paths, err := Connect(server.URL).TraversePaths(&Node{ID: "1"}, desc, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(paths) != 1 {
+		t.Fatal("Paths are not valid", paths)
+	}
+
+	path := paths[0]
+	if path.Start.ID != "1" || path.End.ID != "2" || path.Length != 1 {
+		t.Error("Path is not valid", path)
+	}
+
+	if len(path.Nodes) != 2 || path.Nodes[1].ID != "2" || path.Relationships[0].ID != "7" {
+		t.Error("Path entities are not valid", path.Nodes, path.Relationships)
+	}
+}
+
+func TestTraverseValidation(t *testing.T) {
+	neo4jConnection := Connect("")
+
+	if _, err := neo4jConnection.TraverseNodes(&Node{}, NewTraversal()); err == nil {
+		t.Error("Start node should be validated")
+	}
+
+	if _, err := neo4jConnection.traverse(context.Background(), &Node{ID: "1"}, NewTraversal(), "nodes"); err == nil {
+		t.Error("Return type should be validated")
+	}
+
+	desc := NewTraversal().Relationship("KNOWS", "both")
+	if _, err := neo4jConnection.TraverseRelationships(&Node{ID: "1"}, desc); err == nil {
+		t.Error("Direction should be validated")
+	}
+}
@@ -1,20 +1,36 @@
 package neo4j
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
 )
 
-// var (
-// 	UniquenessGetOrCreate  = "get_or_create"
-// 	UniquenessCreateOrFail = "create_or_fail"
-// )
+// Uniqueness policies of unique create requests
+var (
+	// UniquenessGetOrCreate returns the existing entity if there is one
+	// indexed with the same key and value, creates a new one otherwise
+	UniquenessGetOrCreate = "get_or_create"
+
+	// UniquenessCreateOrFail creates a new entity, the request fails if
+	// there is already one indexed with the same key and value
+	UniquenessCreateOrFail = "create_or_fail"
+)
 
 // Unique struct
 type Unique struct {
-	IndexName  string
+	IndexName string
+	// Uniqueness is one of UniquenessGetOrCreate and UniquenessCreateOrFail,
+	// UniquenessGetOrCreate is used if it is empty
 	Uniqueness string
 	Key        string
-	Value      string
+	Value      interface{}
+
+	// Created is set after the request is executed, it is true if a new
+	// entity is created and false if an existing one is returned
+	Created bool
 }
 
 // UniqueRequest struct used in Batch operations
@@ -23,6 +39,33 @@ type UniqueRequest struct {
 	Data       Batcher
 }
 
+// CreateUnique creates the node or relationship uniquely with the given
+// unique properties, it returns true if a new entity is created and false
+// if an existing one is returned into obj
+//
+// Example usage;
+//
+//	node := &Node{Data: map[string]interface{}{"email": "a@b.c"}}
+//	created, err := neo4jConnection.CreateUnique(node, &Unique{
+//		IndexName: "people",
+//		Key:       "email",
+//		Value:     "a@b.c",
+//	})
+func (neo4j *Neo4j) CreateUnique(obj Batcher, properties *Unique) (bool, error) {
+	return neo4j.CreateUniqueContext(context.Background(), obj, properties)
+}
+
+// CreateUniqueContext is like CreateUnique but aborts the request when ctx
+// is done
+func (neo4j *Neo4j) CreateUniqueContext(ctx context.Context, obj Batcher, properties *Unique) (bool, error) {
+	_, err := neo4j.NewBatch().CreateUnique(obj, properties).ExecuteContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return properties.Created, nil
+}
+
 // Implement Batcher interface
 func (ur *UniqueRequest) getBatchQuery(operation string) (map[string]interface{}, error) {
 
@@ -31,19 +74,30 @@ func (ur *UniqueRequest) getBatchQuery(operation string) (map[string]interface{}
 		return query, errors.New("Index name is empty")
 	}
 
+	if ur.Properties.Key == "" || ur.Properties.Value == nil {
+		query := make(map[string]interface{})
+		return query, errors.New("Key and value must be set")
+	}
+
+	uniqueness := ur.Properties.Uniqueness
+	if uniqueness == "" {
+		uniqueness = UniquenessGetOrCreate
+	}
+
+	if uniqueness != UniquenessGetOrCreate && uniqueness != UniquenessCreateOrFail {
+		query := make(map[string]interface{})
+		return query, fmt.Errorf("Uniqueness %s is not valid", uniqueness)
+	}
+
 	query, err := ur.Data.getBatchQuery(operation)
 	if err != nil {
 		return query, err
 	}
 
-	// to-do add unique parameter passing
-	// http://localhost:7474/db/data/index/relationship/knowledge/?uniqueness=get_or_create
-	// uniqueness := ur.Properties.Uniqueness
-	// if ur.Properties.Uniqueness == "" {
-	// 	uniqueness = UniquenessGetOrCreate
-	// }
+	params := url.Values{}
+	params.Set("uniqueness", uniqueness)
 
-	query["to"] = query["to"].(string) + "/" + ur.Properties.IndexName + "?unique" //=" + uniqueness
+	query["to"] = query["to"].(string) + "/" + url.PathEscape(ur.Properties.IndexName) + "?" + params.Encode()
 	body := query["body"].(map[string]interface{})
 	body["key"] = ur.Properties.Key
 	body["value"] = ur.Properties.Value
@@ -52,6 +106,18 @@ func (ur *UniqueRequest) getBatchQuery(operation string) (map[string]interface{}
 	return query, nil
 }
 
+// Neo4j returns 201 with a location when the entity is created and 200
+// without location when it already exists, the location is used only if
+// the status is not reported
+func (ur *UniqueRequest) receiveBatchResponse(response *BatchResponse) {
+	if response.Status == 0 {
+		ur.Properties.Created = response.Location != ""
+		return
+	}
+
+	ur.Properties.Created = response.Status == http.StatusCreated
+}
+
 func (ur *UniqueRequest) mapBatchResponse(neo4j *Neo4j, data interface{}) (bool, error) {
 	result, err := ur.Data.mapBatchResponse(neo4j, data)
 	return result, err
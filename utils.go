@@ -3,11 +3,12 @@ package neo4j
 // this file works a helper class for other files
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -46,24 +47,97 @@ func getIDFromURL(base, url string) (string, error) {
 	return "", errors.New("URL not valid")
 }
 
-// Gets URL and string data to be sent and makes request
-// reads response body and returns as string
-func (neo4j *Neo4j) doRequest(requestType, url, data string) (string, error) {
+// Returns batch path of the node, ids in "{n}" format refer to the
+// result of the n'th operation in the same batch
+func nodePath(id string) string {
+	if isBatchReference(id) {
+		return id
+	}
+
+	return "/node/" + id
+}
+
+// Returns batch path of the relationship, see nodePath
+func relationshipPath(id string) string {
+	if isBatchReference(id) {
+		return id
+	}
+
+	return "/relationship/" + id
+}
+
+// Checks if id is in "{n}" format
+func isBatchReference(id string) bool {
+	if len(id) < 3 || id[0] != '{' || id[len(id)-1] != '}' {
+		return false
+	}
+
+	_, err := strconv.Atoi(id[1 : len(id)-1])
+	return err == nil
+}
+
+// Prepares a request for the given URL and string data
+// sets json headers and credentials
+func (neo4j *Neo4j) newRequest(ctx context.Context, requestType, url, data string) (*http.Request, error) {
 	//convert string into bytestream
 	dataByte := strings.NewReader(data)
-	req, err := http.NewRequest(requestType, url, dataByte)
+	req, err := http.NewRequestWithContext(ctx, requestType, url, dataByte)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// Neo4j uses json while communicating
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	if (neo4j.BasicAuthUser != "") && (neo4j.BasicAuthPassword != "") {
+	if err := neo4j.setHeaders(req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// Sets the credentials and user agent of the request, Auth is used if set
+// otherwise basic auth credentials
+func (neo4j *Neo4j) setHeaders(req *http.Request) error {
+	if neo4j.UserAgent != "" {
+		req.Header.Set("User-Agent", neo4j.UserAgent)
+	}
+
+	if neo4j.Auth != nil {
+		return neo4j.Auth.Authenticate(req)
+	}
+
+	neo4j.authMu.RLock()
+	defer neo4j.authMu.RUnlock()
+
+	if neo4j.BasicAuthUser != "" {
 		req.SetBasicAuth(neo4j.BasicAuthUser, neo4j.BasicAuthPassword)
 	}
 
+	return nil
+}
+
+// Gets URL and string data to be sent and makes request
+// reads response body and returns as string
+// GET requests are retried according to the retry policy
+func (neo4j *Neo4j) doRequest(ctx context.Context, requestType, url, data string) (string, error) {
+	var response string
+	err := neo4j.retry(ctx, requestType == "GET", func() error {
+		var err error
+		response, err = neo4j.doRequestOnce(ctx, requestType, url, data)
+		return err
+	})
+
+	return response, err
+}
+
+func (neo4j *Neo4j) doRequestOnce(ctx context.Context, requestType, url, data string) (string, error) {
+	req, err := neo4j.newRequest(ctx, requestType, url, data)
+	if err != nil {
+		return "", err
+	}
+
 	// send request
 	res, err := neo4j.Client.Do(req)
 	if err != nil {
@@ -71,22 +145,21 @@ func (neo4j *Neo4j) doRequest(requestType, url, data string) (string, error) {
 	}
 	defer res.Body.Close()
 
-	//todo return proper error messages with message, exception and stacktrace
 	switch requestType {
 	case "GET":
 		// OK
 		if res.StatusCode != 200 {
-			return "", fmt.Errorf(res.Status)
+			return "", newError(res)
 		}
 	case "POST":
-		// Created
-		if res.StatusCode != 201 {
-			return "", fmt.Errorf(res.Status)
+		// Created, some endpoints return OK
+		if res.StatusCode != 201 && res.StatusCode != 200 {
+			return "", newError(res)
 		}
 	case "PUT", "DELETE":
 		// No Content
 		if res.StatusCode != 204 {
-			return "", fmt.Errorf(res.Status)
+			return "", newError(res)
 		}
 		return "", nil
 	default:
@@ -104,40 +177,47 @@ func (neo4j *Neo4j) doRequest(requestType, url, data string) (string, error) {
 
 }
 
-// to-do combine this method with doRequest function
-func (neo4j *Neo4j) doBatchRequest(requestType, url, data string) (string, error) {
-
-	//convert string into bytestream
-	dataByte := strings.NewReader(data)
-	req, err := http.NewRequest(requestType, url, dataByte)
+// Sends the batch request in streaming mode, the response is decoded
+// incrementally and every operation result is passed to handle as soon as
+// it arrives, so a failing operation is detected without reading the rest
+// of the response. The caller keeps the results it needs, Execute keeps all
+// of them so its memory use grows with the batch size, ExecuteFunc keeps none
+func (neo4j *Neo4j) doBatchRequest(ctx context.Context, requestType, url, data string, handle func(*BatchResponse) error) error {
+	req, err := neo4j.newRequest(ctx, requestType, url, data)
 	if err != nil {
-		return "", err
-	}
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-
-	if (neo4j.BasicAuthUser != "") && (neo4j.BasicAuthPassword != "") {
-		req.SetBasicAuth(neo4j.BasicAuthUser, neo4j.BasicAuthPassword)
+		return err
 	}
+	req.Header.Set("X-Stream", "true")
 
 	res, err := neo4j.Client.Do(req)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return "", fmt.Errorf(res.Status)
+		return newError(res)
 	}
 
-	// read response body
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		//A successful call returns err == nil
-		return "", err
+	decoder := json.NewDecoder(res.Body)
+
+	// read opening bracket of the response array
+	if _, err := decoder.Token(); err != nil {
+		return err
 	}
 
-	return string(body), nil
+	for decoder.More() {
+		response := &BatchResponse{}
+		if err := decoder.Decode(response); err != nil {
+			return err
+		}
+
+		if err := handle(response); err != nil {
+			return err
+		}
+	}
 
+	// read closing bracket
+	_, err = decoder.Token()
+	return err
 }